@@ -0,0 +1,189 @@
+package pluralize
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Diagnostic -- count agreement mismatch, Offset and End are the byte range of the noun in the text.
+type Diagnostic struct {
+	Offset     int
+	End        int
+	Number     string
+	Noun       string
+	Suggestion string
+}
+
+// String -- stringify Diagnostic (e.g. offset 2: "1 files" should be "1 file").
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("offset %d: %q should be %q", d.Offset, d.Number+` `+d.Noun, d.Number+` `+d.Suggestion)
+}
+
+// CheckAgreement -- scan text for number-noun pairs (e.g. 1 files, two item) whose noun does not
+// agree with the number, returns a diagnostic with a suggested fix for each mismatch.
+// The noun is the first word following the number which is not an adjective (e.g. 2 large files),
+// words after the noun are not checked (e.g. 1 file remains). After a plural number a singular word
+// followed by a plural word is taken as an unknown adjective (e.g. 2 shiny apples).
+// Nouns which are both singular and plural (e.g. sheep) and decimal numbers are not checked.
+func (c *Client) CheckAgreement(text string) []Diagnostic {
+	var diagnostics []Diagnostic
+
+	tokens := Tokenize(text)
+
+	for i := 0; i+2 < len(tokens); i++ {
+		number := tokens[i]
+
+		single, ok := numberIsSingle(number)
+		if !ok {
+			continue
+		}
+
+		noun, ok := c.nounHead(c.nounPhrase(tokens[i+1:]), single)
+		if !ok {
+			continue
+		}
+
+		suggestion := ``
+
+		switch {
+		case single && c.IsPlural(noun.Text) && !c.IsSingular(noun.Text):
+			suggestion = c.Singular(noun.Text)
+		case !single && c.IsSingular(noun.Text) && !c.IsPlural(noun.Text):
+			suggestion = c.Plural(noun.Text)
+		}
+
+		if len(suggestion) == 0 || suggestion == noun.Text {
+			continue
+		}
+
+		diagnostics = append(diagnostics, Diagnostic{
+			Offset:     noun.Offset,
+			End:        noun.Offset + len(noun.Text),
+			Number:     number.Text,
+			Noun:       noun.Text,
+			Suggestion: suggestion,
+		})
+	}
+
+	return diagnostics
+}
+
+// nounPhrase -- the space separated words following a number, up to punctuation, a number or a not-noun word.
+func (c *Client) nounPhrase(tokens []Token) []Token {
+	var phrase []Token
+
+	for j := 0; j+1 < len(tokens); j += 2 {
+		space, word := tokens[j], tokens[j+1]
+
+		if space.Kind != TokenSpace || word.Kind != TokenWord || c.isNotNoun(word.Text) {
+			break
+		}
+
+		if _, ok := numberIsSingle(word); ok {
+			break
+		}
+
+		phrase = append(phrase, word)
+	}
+
+	return phrase
+}
+
+// nounHead -- the noun of a phrase following a number, its first word which is not an adjective.
+func (c *Client) nounHead(phrase []Token, single bool) (Token, bool) {
+	for j, word := range phrase {
+		if c.isAdjective(word.Text) {
+			continue
+		}
+
+		if !single && j+1 < len(phrase) && !c.IsPlural(word.Text) && c.IsPlural(phrase[j+1].Text) {
+			continue
+		}
+
+		return word, true
+	}
+
+	return Token{}, false
+}
+
+// AddNotNounRule -- Add a word which ends the noun phrase following a number and is never checked (e.g. of, more).
+func (c *Client) AddNotNounRule(word string) {
+	c.notNouns[strings.ToLower(word)] = true
+}
+
+func (c *Client) isNotNoun(word string) bool {
+	return c.notNouns[strings.ToLower(word)]
+}
+
+// AddAdjectiveRule -- Add a word which is skipped when it precedes the noun following a number (e.g. new, large).
+func (c *Client) AddAdjectiveRule(word string) {
+	c.adjectives[strings.ToLower(word)] = true
+}
+
+func (c *Client) isAdjective(word string) bool {
+	return c.adjectives[strings.ToLower(word)]
+}
+
+// numberIsSingle -- classify a number token, ok is false when the token is not a number.
+func numberIsSingle(t Token) (single bool, ok bool) {
+	if t.Kind == TokenNumber {
+		if strings.Contains(t.Text, `.`) {
+			return false, false
+		}
+
+		return strings.TrimLeft(strings.ReplaceAll(t.Text, `,`, ``), `0`) == `1`, true
+	}
+
+	if t.Kind != TokenWord {
+		return false, false
+	}
+
+	parts := strings.Split(strings.ToLower(t.Text), `-`)
+	for _, p := range parts {
+		if !numberWords[p] {
+			return false, false
+		}
+	}
+
+	return len(parts) == 1 && parts[0] == `one`, true
+}
+
+// numberWords -- spelled-out numbers, compound numbers are hyphenated (e.g. twenty-one).
+var numberWords = map[string]bool{ //nolint:gochecknoglobals
+	`zero`: true, `one`: true, `two`: true, `three`: true, `four`: true, `five`: true,
+	`six`: true, `seven`: true, `eight`: true, `nine`: true, `ten`: true, `eleven`: true,
+	`twelve`: true, `thirteen`: true, `fourteen`: true, `fifteen`: true, `sixteen`: true,
+	`seventeen`: true, `eighteen`: true, `nineteen`: true, `twenty`: true, `thirty`: true,
+	`forty`: true, `fifty`: true, `sixty`: true, `seventy`: true, `eighty`: true, `ninety`: true,
+	`hundred`: true, `thousand`: true, `million`: true, `billion`: true, `dozen`: true,
+}
+
+func (c *Client) loadNotNounRules() {
+	var notNounRules = []string{
+		// Prepositions and conjunctions.
+		`about`, `after`, `and`, `as`, `at`, `before`, `but`, `by`, `for`, `from`, `if`, `in`,
+		`into`, `of`, `on`, `or`, `out`, `over`, `per`, `than`, `that`, `to`, `under`, `up`, `with`,
+		// Comparatives and quantifiers.
+		`another`, `fewer`, `less`, `more`, `most`, `total`,
+		// Verbs.
+		`am`, `are`, `be`, `can`, `did`, `do`, `had`, `has`, `have`, `is`, `may`, `must`, `should`,
+		`was`, `were`, `will`, `would`,
+		// Time of day.
+		`o'clock`, `pm`,
+	}
+
+	for _, w := range notNounRules {
+		c.AddNotNounRule(w)
+	}
+}
+
+func (c *Client) loadAdjectiveRules() {
+	var adjectiveRules = []string{
+		`additional`, `bad`, `big`, `different`, `empty`, `extra`, `full`, `good`, `great`, `high`,
+		`large`, `little`, `long`, `low`, `new`, `old`, `other`, `same`, `short`, `single`, `small`,
+	}
+
+	for _, w := range adjectiveRules {
+		c.AddAdjectiveRule(w)
+	}
+}
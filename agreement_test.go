@@ -0,0 +1,100 @@
+package pluralize //nolint:testpackage
+
+import (
+	"testing"
+)
+
+func TestCheckAgreement(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected []Diagnostic
+	}{
+		{`Deleted 1 files.`, []Diagnostic{{10, 15, `1`, `files`, `file`}}},
+		{`Found 2 item and 3 Box`, []Diagnostic{{8, 12, `2`, `item`, `items`}, {19, 22, `3`, `Box`, `Boxes`}}},
+		{`One children, two person`, []Diagnostic{{4, 12, `One`, `children`, `child`}, {18, 24, `two`, `person`, `people`}}},
+		{`twenty-one file`, []Diagnostic{{11, 15, `twenty-one`, `file`, `files`}}},
+		{`1,000 user`, []Diagnostic{{6, 10, `1,000`, `user`, `users`}}},
+		{`0 result`, []Diagnostic{{2, 8, `0`, `result`, `results`}}},
+		// Adjectives are skipped and words after the noun are not checked.
+		{`2 large file and 1 small boxes`, []Diagnostic{{8, 12, `2`, `file`, `files`}, {25, 30, `1`, `boxes`, `box`}}},
+		{`1 new files`, []Diagnostic{{6, 11, `1`, `files`, `file`}}},
+		{`Deleted 2 file today`, []Diagnostic{{10, 14, `2`, `file`, `files`}}},
+		{`wait 5 second please`, []Diagnostic{{7, 13, `5`, `second`, `seconds`}}},
+		{`2 file remaining`, []Diagnostic{{2, 6, `2`, `file`, `files`}}},
+		// Agreeing pairs.
+		{`1 file, 2 files, one child, 3 people`, nil},
+		{`2 large files, 3 red apples, 2 o'clock, 2 files remaining, 1 big file`, nil},
+		{`1 file exists, 2 shiny apples, 3 other, 1 other file`, nil},
+		// Uncountables, decimals, ordinals and non-nouns are not checked.
+		{`2 sheep, 5 fish, 1.5 hour, 1st place, 2 of them, 3 more, 1 or 2`, nil},
+		// Numbers must be followed by a space and a word.
+		{`1files 2-item 3.`, nil},
+		{``, nil},
+	}
+
+	pluralize := NewClient()
+
+	for i, test := range tests {
+		actual := pluralize.CheckAgreement(test.text)
+
+		if len(actual) != len(test.expected) {
+			t.Errorf("FAIL test[%d] CheckAgreement(%s) expected %v, actual %v", i, test.text, test.expected, actual)
+			continue
+		}
+
+		for j := range actual {
+			if actual[j] != test.expected[j] {
+				t.Errorf("FAIL test[%d] CheckAgreement(%s) expected %v, actual %v", i, test.text, test.expected[j], actual[j])
+			}
+
+			if test.text[actual[j].Offset:actual[j].End] != actual[j].Noun {
+				t.Errorf("FAIL test[%d] CheckAgreement(%s) offsets do not match noun %v", i, test.text, actual[j])
+			}
+		}
+	}
+}
+
+func TestDiagnosticString(t *testing.T) {
+	d := Diagnostic{10, 15, `1`, `files`, `file`}
+
+	if d.String() != `offset 10: "1 files" should be "1 file"` {
+		t.Errorf("unexpected %s", d.String())
+	}
+}
+
+func TestNewNotNounRule(t *testing.T) {
+	pluralize := NewClient()
+
+	if len(pluralize.CheckAgreement(`2 big files`)) != 0 {
+		t.Fail()
+	}
+
+	if d := pluralize.CheckAgreement(`2 file deleted`); len(d) != 1 || d[0].Noun != `file` {
+		t.Fail()
+	}
+
+	if d := pluralize.CheckAgreement(`1 files found`); len(d) != 1 || d[0].Noun != `files` {
+		t.Fail()
+	}
+
+	pluralize.AddNotNounRule(`files`)
+
+	if len(pluralize.CheckAgreement(`1 files found`)) != 0 {
+		t.Fail()
+	}
+}
+
+func TestNewAdjectiveRule(t *testing.T) {
+	pluralize := NewClient()
+
+	// Without the rule the adjective is taken as the noun.
+	if len(pluralize.CheckAgreement(`1 shiny apples`)) != 0 {
+		t.Fail()
+	}
+
+	pluralize.AddAdjectiveRule(`shiny`)
+
+	if d := pluralize.CheckAgreement(`1 shiny apples`); len(d) != 1 || d[0].Noun != `apples` {
+		t.Fail()
+	}
+}
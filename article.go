@@ -0,0 +1,76 @@
+package pluralize
+
+import (
+	"strings"
+)
+
+// Article -- Return the indefinite article (`a` or `an`) for a word based on its pronunciation.
+func (c *Client) Article(word string) string {
+	token := strings.TrimSpace(word)
+
+	// Iterate over the article rules and use the first one to match.
+	// NOTE: iterate rules array in reverse order specific => general rules
+	for i := len(c.articleRules) - 1; i >= 0; i-- {
+		if c.articleRules[i].expression.MatchString(token) {
+			return c.articleRules[i].replacement
+		}
+	}
+
+	return `a`
+}
+
+// PluralizeArticle -- Pluralize or singularize a word based on the passed in count,
+// using the indefinite article instead of the number when count is 1 (e.g. an hour, 3 hours).
+// An empty word is returned unchanged.
+func (c *Client) PluralizeArticle(word string, count int) string {
+	if len(word) == 0 {
+		return word
+	}
+
+	if count == 1 {
+		single := c.Singular(word)
+		return c.Article(single) + ` ` + single
+	}
+
+	return c.Pluralize(word, count, true)
+}
+
+// AddArticleRule -- Add an indefinite article rule to the collection.
+func (c *Client) AddArticleRule(rule string, article string) {
+	c.articleRules = append(c.articleRules, Rule{expression: sanitizeRule(rule), replacement: article})
+}
+
+func (c *Client) loadArticleRules() {
+	var articleRules = []struct {
+		rule    string
+		article string
+	}{
+		// Vowel sounds.
+		{`(?i)^[aeiou]`, `an`},
+		// Single letters pronounced with a leading vowel sound (e.g. an f, an x-ray, a u-turn).
+		{`(?i)^[aefhilmnorsx](?:[^a-z]|$)`, `an`},
+		{`(?i)^u(?:[^a-z]|$)`, `a`},
+		// Leading `u` pronounced `you` (e.g. a user, a utility, a university).
+		{`(?i)^u[bcfklqrst][aeiou]`, `a`},
+		{`(?i)^uni`, `a`},
+		{`(?i)^un(?:in|im|ide)`, `an`},
+		// Leading `eu` and `ewe` pronounced `you` (e.g. a european, a ewe).
+		{`(?i)^(?:eu|ewe)`, `a`},
+		// Leading `o` pronounced `w` (e.g. a one-time fee).
+		{`(?i)^on(?:ce|e)\b`, `a`},
+		// Silent `h` (e.g. an hour, an honest).
+		{`(?i)^(?:heir|hour|honest|honou?r)`, `an`},
+		// Numbers (e.g. an 8, an 11, an 18,000).
+		{`(?i)^8`, `an`},
+		{`(?i)^1[18](?:,?[0-9]{3})*(?:[^0-9,]|$)`, `an`},
+		// Acronyms read letter by letter (e.g. an FAQ, an HTML, a URL).
+		{`(?-i)^[AEFHILMNORSX](?:[A-Z0-9]{1,2}|[B-DF-HJ-NP-TV-Z0-9]+)s?(?:[^A-Za-z]|$)`, `an`},
+		{`(?-i)^[BCDGJKPQTUVWYZ](?:[A-Z0-9]{1,2}|[B-DF-HJ-NP-TV-Z0-9]+)s?(?:[^A-Za-z]|$)`, `a`},
+		// Acronyms read as words (e.g. a LAN, a RAM).
+		{`(?-i)^(?:LAN|NAT|NIC|RAM|ROM|SAN|SIM|HUD)s?(?:[^A-Za-z]|$)`, `a`},
+	}
+
+	for _, r := range articleRules {
+		c.AddArticleRule(r.rule, r.article)
+	}
+}
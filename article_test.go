@@ -0,0 +1,118 @@
+package pluralize //nolint:testpackage
+
+import (
+	"testing"
+)
+
+func TestArticle(t *testing.T) { //nolint:funlen
+	tests := []TestEntry{
+		// Consonant and vowel sounds.
+		{`duck`, `a`},
+		{`apple`, `an`},
+		{`Elephant`, `an`},
+		{`umbrella`, `an`},
+		{`ugly`, `an`},
+		{``, `a`},
+		// Silent `h`.
+		{`hour`, `an`},
+		{`honest`, `an`},
+		{`honour`, `an`},
+		{`heir`, `an`},
+		{`house`, `a`},
+		{`hotel`, `a`},
+		// Leading `u`, `eu` and `o` pronounced as consonants.
+		{`university`, `a`},
+		{`unicorn`, `a`},
+		{`user`, `a`},
+		{`utility`, `a`},
+		{`unimportant`, `an`},
+		{`uninstall`, `an`},
+		{`euro`, `a`},
+		{`European`, `a`},
+		{`ewe`, `a`},
+		{`one-time`, `a`},
+		{`onerous`, `an`},
+		// Single letters.
+		{`x-ray`, `an`},
+		{`u-turn`, `a`},
+		{`s`, `an`},
+		{`b`, `a`},
+		// Acronyms.
+		{`FAQ`, `an`},
+		{`FAQs`, `an`},
+		{`HTML`, `an`},
+		{`SQL`, `an`},
+		{`MRI`, `an`},
+		{`URL`, `a`},
+		{`USB`, `a`},
+		{`FBI`, `an`},
+		{`EU`, `an`},
+		{`NASA`, `a`},
+		{`NATO`, `a`},
+		{`LAN`, `a`},
+		{`HOUR`, `an`},
+		// Numbers.
+		{`8`, `an`},
+		{`80`, `an`},
+		{`11`, `an`},
+		{`18,000`, `an`},
+		{`110`, `a`},
+		{`1`, `a`},
+	}
+	passed := 0
+	failed := 0
+
+	pluralize := NewClient()
+
+	for i, testItem := range tests {
+		if actual := pluralize.Article(testItem.input); actual == testItem.expected {
+			plogf(t, "PASS test[%d] func %s(%s) expected %s, actual %s", i, "Article",
+				testItem.input, testItem.expected, actual)
+			passed++
+		} else {
+			t.Errorf("FAIL test[%d] func %s(%s) expected %s, actual %s", i, "Article",
+				testItem.input, testItem.expected, actual)
+			failed++
+		}
+	}
+
+	slog("TestArticle", passed, failed, len(tests))
+}
+
+func TestPluralizeArticle(t *testing.T) {
+	pluralize := NewClient()
+
+	if pluralize.PluralizeArticle(`duck`, 1) != `a duck` {
+		t.Fail()
+	}
+
+	if pluralize.PluralizeArticle(`hours`, 1) != `an hour` {
+		t.Fail()
+	}
+
+	if pluralize.PluralizeArticle(`hour`, 3) != `3 hours` {
+		t.Fail()
+	}
+
+	if pluralize.PluralizeArticle(`university`, 0) != `0 universities` {
+		t.Fail()
+	}
+
+	if pluralize.PluralizeArticle(``, 1) != `` || pluralize.PluralizeArticle(``, 2) != `` {
+		t.Fail()
+	}
+}
+
+func TestNewArticleRule(t *testing.T) {
+	pluralize := NewClient()
+
+	if pluralize.Article(`herb`) != `a` {
+		t.Fail()
+	}
+
+	pluralize.AddArticleRule(`herb`, `an`)
+
+	if pluralize.Article(`herb`) != `an` {
+		t.Fail()
+	}
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+	"github.com/gertd/go-pluralize/pkg/tflags"
+)
+
+// forEachLine -- call fn for each line read from the files, or stdin when no files are specified.
+func forEachLine(files []string, fn func(line string) error) error {
+	if len(files) == 0 {
+		return eachLine(os.Stdin, fn)
+	}
+
+	for _, file := range files {
+		if err := eachFileLine(file, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func eachFileLine(file string, fn func(line string) error) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return eachLine(f, fn)
+}
+
+func eachLine(r io.Reader, fn func(line string) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), 1024*1024)
+
+	for scanner.Scan() {
+		if err := fn(strings.TrimRight(scanner.Text(), "\r")); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// results -- results of the test command for word, in IsPlural, IsSingular, Plural, Singular, Pluralize order.
+func results(client *pluralize.Client, testCmd tflags.TestCmd, word string, count int, inclusive bool) []string {
+	var r []string
+
+	if testCmd.Has(tflags.TestCmdIsPlural) {
+		r = append(r, strconv.FormatBool(client.IsPlural(word)))
+	}
+
+	if testCmd.Has(tflags.TestCmdIsSingular) {
+		r = append(r, strconv.FormatBool(client.IsSingular(word)))
+	}
+
+	if testCmd.Has(tflags.TestCmdPlural) {
+		r = append(r, client.Plural(word))
+	}
+
+	if testCmd.Has(tflags.TestCmdSingular) {
+		r = append(r, client.Singular(word))
+	}
+
+	if testCmd.Has(tflags.TestCmdPluralize) {
+		r = append(r, client.Pluralize(word, count, inclusive))
+	}
+
+	return r
+}
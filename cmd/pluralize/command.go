@@ -0,0 +1,260 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// errExit -- exit with status 1 without printing an error, the reason was already reported
+// (flag parse errors) or is the result of the command (is-plural and is-singular).
+var errExit = errors.New("exit status 1")
+
+// command -- CLI subcommand.
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(cmd *command, w io.Writer, args []string) error
+	help    io.Writer // usage output when help is requested, the flag set default (stderr) when nil
+}
+
+// commands -- CLI subcommands in help order.
+func commands() []*command {
+	return []*command{
+		{name: "plural", args: "[word ...]", summary: "Print the plural form of each word.", run: runPlural},
+		{name: "singular", args: "[word ...]", summary: "Print the singular form of each word.", run: runSingular},
+		{
+			name: "is-plural", args: "[word ...]", run: runIsPlural,
+			summary: "Print whether each word is plural, exit status 0 when all words are plural, 1 otherwise.",
+		},
+		{
+			name: "is-singular", args: "[word ...]", run: runIsSingular,
+			summary: "Print whether each word is singular, exit status 0 when all words are singular, 1 otherwise.",
+		},
+		{
+			name: "count", args: "count [word ...]", run: runCount,
+			summary: "Print each word pluralized by count (e.g. 3 duck => ducks).",
+		},
+		{
+			name: "explain", args: "[word ...]", run: runExplain,
+			summary: "Print the stage or rule which resolved the plural and singular form of each word.",
+		},
+		{
+			name: "table", args: "[word ...]", run: runTable,
+			summary: "Print an aligned table of each word, its plural, singular and round-trip check, exit status 1 on inconsistencies.", //nolint:lll
+		},
+		{
+			name: "repl", run: runRepl,
+			summary: "Interactively add rules, evaluate words and save the session rules to a file.",
+		},
+		{
+			name: "serve", run: runServe,
+			summary: "Serve plural, singular, classify and pluralize as an HTTP JSON and optional gRPC service.",
+		},
+	}
+}
+
+// lookupCommand -- find a command by name, nil when not found.
+func lookupCommand(name string) *command {
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+
+	return nil
+}
+
+// usage -- print the command overview.
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s <command> [flags] [word ...]\n\nCommands:\n", appName)
+
+	for _, cmd := range commands() {
+		fmt.Fprintf(w, "  %-12s %s\n", cmd.name, cmd.summary)
+	}
+
+	fmt.Fprintf(w, "  %-12s %s\n", "help", "Print help for a command.")
+	fmt.Fprintf(w, "  %-12s %s\n", "version", "Print version info.")
+	fmt.Fprintf(w, "\nWords are read line by line from stdin when no words are specified.\n")
+	fmt.Fprintf(w, "Run '%s help <command>' for the flags of a command.\n", appName)
+}
+
+// flagSet -- command flag set with per-command usage.
+func (c *command) flagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet(appName+" "+c.name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: %s\n\n%s\n\nFlags:\n",
+			strings.TrimSpace(fmt.Sprintf("%s %s [flags] %s", appName, c.name, c.args)), c.summary)
+		fs.PrintDefaults()
+	}
+
+	if c.help != nil {
+		fs.SetOutput(c.help)
+	}
+
+	return fs
+}
+
+// parse -- parse the command flags, returning the client with the -rules files loaded.
+func parse(fs *flag.FlagSet, args []string) (*pluralize.Client, error) {
+	var ruleFiles fileList
+
+	fs.Var(&ruleFiles, "rules", "load plural, singular, irregular and uncountable rules from file (repeatable)")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, err
+		}
+
+		return nil, errExit
+	}
+
+	client := pluralize.NewClient()
+
+	if err := loadRuleFiles(client, ruleFiles); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// forEachWord -- call fn for each word argument, or each line read from stdin when there are none.
+func forEachWord(words []string, fn func(word string) error) error {
+	if len(words) == 0 {
+		return eachLine(os.Stdin, fn)
+	}
+
+	for _, word := range words {
+		if err := fn(word); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func runPlural(cmd *command, w io.Writer, args []string) error {
+	return runInflect(cmd, w, args, (*pluralize.Client).Plural)
+}
+
+func runSingular(cmd *command, w io.Writer, args []string) error {
+	return runInflect(cmd, w, args, (*pluralize.Client).Singular)
+}
+
+func runInflect(cmd *command, w io.Writer, args []string, inflect func(*pluralize.Client, string) string) error {
+	fs := cmd.flagSet()
+
+	client, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	return forEachWord(fs.Args(), func(word string) error {
+		_, err := fmt.Fprintln(w, inflect(client, word))
+		return err
+	})
+}
+
+func runIsPlural(cmd *command, w io.Writer, args []string) error {
+	return runIs(cmd, w, args, (*pluralize.Client).IsPlural)
+}
+
+func runIsSingular(cmd *command, w io.Writer, args []string) error {
+	return runIs(cmd, w, args, (*pluralize.Client).IsSingular)
+}
+
+func runIs(cmd *command, w io.Writer, args []string, is func(*pluralize.Client, string) bool) error {
+	fs := cmd.flagSet()
+	quiet := fs.Bool("quiet", false, "print nothing, only set the exit status")
+
+	client, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	all := true
+
+	err = forEachWord(fs.Args(), func(word string) error {
+		result := is(client, word)
+		all = all && result
+
+		if *quiet {
+			return nil
+		}
+
+		_, err := fmt.Fprintln(w, result)
+
+		return err
+	})
+
+	switch {
+	case err != nil:
+		return err
+	case !all:
+		return errExit
+	default:
+		return nil
+	}
+}
+
+func runCount(cmd *command, w io.Writer, args []string) error {
+	fs := cmd.flagSet()
+	inclusive := fs.Bool("inclusive", false, "prefix the result with the count (e.g. 3 ducks)")
+
+	client, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() == 0 {
+		return fmt.Errorf("%s: count not specified", cmd.name)
+	}
+
+	count, err := strconv.Atoi(fs.Arg(0))
+	if err != nil {
+		return fmt.Errorf("%s: invalid count %q", cmd.name, fs.Arg(0))
+	}
+
+	return forEachWord(fs.Args()[1:], func(word string) error {
+		_, err := fmt.Fprintln(w, client.Pluralize(word, count, *inclusive))
+		return err
+	})
+}
+
+func runExplain(cmd *command, w io.Writer, args []string) error {
+	fs := cmd.flagSet()
+	word := fs.String("word", "", "word to explain, in addition to the word arguments")
+	output := fs.String("output", outputText, "output format [text|json]")
+
+	client, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	var explain func(w io.Writer, client *pluralize.Client, word string) error
+
+	switch strings.ToLower(*output) {
+	case outputText:
+		explain = printExplain
+	case outputJSON:
+		explain = printExplainJSON
+	default:
+		return fmt.Errorf("%s: unknown -output value %s, valid [%s|%s]", cmd.name, *output, outputText, outputJSON)
+	}
+
+	words := fs.Args()
+	if len(*word) > 0 {
+		words = append([]string{*word}, words...)
+	}
+
+	return forEachWord(words, func(word string) error {
+		return explain(w, client, word)
+	})
+}
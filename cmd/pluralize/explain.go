@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// explainRecord -- JSON output of the explain command.
+type explainRecord struct {
+	Word     string      `json:"word"`
+	Plural   traceRecord `json:"plural"`
+	Singular traceRecord `json:"singular"`
+}
+
+// traceRecord -- JSON representation of pluralize.Trace, the rule fields are only set for the rule stage,
+// the expression also for uncountable regular expressions.
+type traceRecord struct {
+	Result      string   `json:"result"`
+	Stage       string   `json:"stage"`
+	Rule        *int     `json:"rule,omitempty"`
+	Expression  string   `json:"expression,omitempty"`
+	Replacement *string  `json:"replacement,omitempty"`
+	Match       *string  `json:"match,omitempty"`
+	Captures    []string `json:"captures,omitempty"`
+}
+
+func newTraceRecord(t pluralize.Trace) traceRecord {
+	r := traceRecord{Result: t.Result, Stage: t.Stage.String(), Expression: t.Expression}
+
+	if t.Stage == pluralize.StageRule {
+		r.Rule = &t.RuleIndex
+		r.Replacement = &t.Replacement
+		r.Match = &t.Match
+		r.Captures = t.Captures
+	}
+
+	return r
+}
+
+// printExplain -- print the stage or rule resolving the plural and singular form of word.
+func printExplain(w io.Writer, client *pluralize.Client, word string) error {
+	fmt.Fprintf(w, "%s\n", word)
+	printTrace(w, "plural", client.TracePlural(word))
+	printTrace(w, "singular", client.TraceSingular(word))
+
+	return nil
+}
+
+func printTrace(w io.Writer, name string, t pluralize.Trace) {
+	fmt.Fprintf(w, "  %-9s %s\n", name+":", t.Result)
+
+	if t.Stage != pluralize.StageRule {
+		fmt.Fprintf(w, "    stage:       %s\n", t.Stage)
+
+		if len(t.Expression) > 0 {
+			fmt.Fprintf(w, "    expression:  %s\n", t.Expression)
+		}
+
+		return
+	}
+
+	captures := make([]string, len(t.Captures))
+	for i, c := range t.Captures {
+		captures[i] = fmt.Sprintf("$%d=%q", i+1, c)
+	}
+
+	fmt.Fprintf(w, "    stage:       %s %d\n", t.Stage, t.RuleIndex)
+	fmt.Fprintf(w, "    expression:  %s\n", t.Expression)
+	fmt.Fprintf(w, "    match:       %q\n", t.Match)
+
+	if len(captures) > 0 {
+		fmt.Fprintf(w, "    captures:    %s\n", strings.Join(captures, " "))
+	}
+
+	fmt.Fprintf(w, "    replacement: %s\n", t.Replacement)
+}
+
+// printExplainJSON -- print the explanation as one JSON object per line.
+func printExplainJSON(w io.Writer, client *pluralize.Client, word string) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+
+	return enc.Encode(explainRecord{
+		Word:     word,
+		Plural:   newTraceRecord(client.TracePlural(word)),
+		Singular: newTraceRecord(client.TraceSingular(word)),
+	})
+}
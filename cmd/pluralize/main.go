@@ -1,9 +1,13 @@
 package main
 
 import (
+	"bufio"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/gertd/go-pluralize"
 	"github.com/gertd/go-pluralize/pkg/tflags"
@@ -15,51 +19,194 @@ const (
 )
 
 func main() {
+	out := bufio.NewWriter(os.Stdout)
+
+	err := run(out, os.Args[1:])
+
+	if flushErr := out.Flush(); err == nil {
+		err = flushErr
+	}
+
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+	case errors.Is(err, errExit):
+		os.Exit(1)
+	default:
+		exitOnError(err)
+	}
+}
+
+// run -- dispatch to the subcommand, arguments starting with a flag use the -cmd flag interface.
+func run(w io.Writer, args []string) error {
+	if len(args) == 0 {
+		usage(os.Stderr)
+		return errExit
+	}
+
+	if strings.HasPrefix(args[0], "-") {
+		return runFlags(w, args)
+	}
+
+	switch args[0] {
+	case "help":
+		return runHelp(w, args[1:])
+	case "version":
+		displayVersionInfo(w, appName)
+		return nil
+	}
+
+	cmd := lookupCommand(args[0])
+	if cmd == nil {
+		fmt.Fprintf(os.Stderr, "%s: unknown command %q\n\n", appName, args[0])
+		usage(os.Stderr)
+
+		return errExit
+	}
+
+	return cmd.run(cmd, w, args[1:])
+}
+
+func runHelp(w io.Writer, args []string) error {
+	if len(args) == 0 {
+		usage(w)
+		return nil
+	}
+
+	cmd := lookupCommand(args[0])
+	if cmd == nil {
+		return fmt.Errorf("unknown command %q", args[0])
+	}
+
+	cmd.help = w
+
+	return cmd.run(cmd, w, []string{"-help"})
+}
+
+// runFlags -- the -word and -cmd flag interface.
+func runFlags(w io.Writer, args []string) error { //nolint:funlen,gocyclo
+	fs := flag.NewFlagSet(appName, flag.ContinueOnError)
+	fs.Usage = func() {
+		usage(fs.Output())
+		fmt.Fprintf(fs.Output(), "\nUsage: %s [flags]\n\nFlags:\n", appName)
+		fs.PrintDefaults()
+	}
+
 	var (
-		word        = flag.String("word", "", "input value")
-		cmd         = flag.String("cmd", "All", "command [All|IsPlural|IsSingular|Plural|Singular]")
-		showVersion = flag.Bool("version", false, "display version info")
+		word        = fs.String("word", "", "input value")
+		cmd         = fs.String("cmd", "All", "command [All|IsPlural|IsSingular|Plural|Singular|Pluralize]")
+		count       = fs.Int("count", 1, "count for the Pluralize command")
+		inclusive   = fs.Bool("inclusive", false, "prefix the Pluralize result with the count (e.g. 3 ducks)")
+		batch       = fs.Bool("batch", false, "read words line by line from stdin or the file arguments")
+		output      = fs.String("output", outputText, "output format [text|json|csv|tsv]")
+		withRule    = fs.Bool("rule", false, "include the matched plural and singular rules in json|csv|tsv output")
+		showVersion = fs.Bool("version", false, "display version info")
+		ruleFiles   fileList
 	)
 
-	flag.Parse()
+	fs.Var(&ruleFiles, "rules", "load plural, singular, irregular and uncountable rules from file (repeatable)")
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
 
-	if showVersion != nil && *showVersion {
-		displayVersionInfo(appName)
-		return
+		return errExit
 	}
 
-	if word == nil || len(*word) == 0 {
-		fmt.Printf("-word not specified\n")
-		return
+	if *showVersion {
+		displayVersionInfo(w, appName)
+		return nil
 	}
 
-	pluralize := pluralize.NewClient()
+	if !*batch && len(*word) == 0 {
+		return fmt.Errorf("-word not specified")
+	}
 
 	testCmd := tflags.TestCmdString(*cmd)
 	if testCmd.Has(tflags.TestCmdUnknown) {
-		fmt.Printf("Unknown -cmd value\nOptions: [All|IsPlural|IsSingular|Plural|Singular]\n")
-		return
+		return fmt.Errorf("unknown -cmd value %s, valid [All|IsPlural|IsSingular|Plural|Singular|Pluralize]", *cmd)
 	}
 
+	pluralize := pluralize.NewClient()
+
+	if err := loadRuleFiles(pluralize, ruleFiles); err != nil {
+		return err
+	}
+
+	var (
+		handler func(word string) error
+		flush   = func() error { return nil }
+	)
+
+	switch {
+	case !strings.EqualFold(*output, outputText):
+		rw, err := newRecordWriter(*output, w, *withRule)
+		if err != nil {
+			return err
+		}
+
+		flush = rw.Flush
+		handler = func(word string) error {
+			return rw.Write(newRecord(pluralize, word, *count, *inclusive, *withRule))
+		}
+	case *batch:
+		handler = func(word string) error {
+			_, err := fmt.Fprintln(w, strings.Join(results(pluralize, testCmd, word, *count, *inclusive), "\t"))
+			return err
+		}
+	default:
+		handler = func(word string) error {
+			printResults(w, pluralize, testCmd, word, *count, *inclusive)
+			return nil
+		}
+	}
+
+	var err error
+	if *batch {
+		err = forEachLine(fs.Args(), handler)
+	} else {
+		err = handler(*word)
+	}
+
+	if flushErr := flush(); err == nil {
+		err = flushErr
+	}
+
+	return err
+}
+
+func printResults(w io.Writer, pluralize *pluralize.Client, testCmd tflags.TestCmd, word string, count int, inclusive bool) { //nolint:lll
 	if testCmd.Has(tflags.TestCmdIsPlural) {
-		fmt.Printf("IsPlural(%s)   => %t\n", *word, pluralize.IsPlural(*word))
+		fmt.Fprintf(w, "IsPlural(%s)   => %t\n", word, pluralize.IsPlural(word))
 	}
 
 	if testCmd.Has(tflags.TestCmdIsSingular) {
-		fmt.Printf("IsSingular(%s) => %t\n", *word, pluralize.IsSingular(*word))
+		fmt.Fprintf(w, "IsSingular(%s) => %t\n", word, pluralize.IsSingular(word))
 	}
 
 	if testCmd.Has(tflags.TestCmdPlural) {
-		fmt.Printf("Plural(%s)     => %s\n", *word, pluralize.Plural(*word))
+		fmt.Fprintf(w, "Plural(%s)     => %s\n", word, pluralize.Plural(word))
 	}
 
 	if testCmd.Has(tflags.TestCmdSingular) {
-		fmt.Printf("Singular(%s)   => %s\n", *word, pluralize.Singular(*word))
+		fmt.Fprintf(w, "Singular(%s)   => %s\n", word, pluralize.Singular(word))
+	}
+
+	if testCmd.Has(tflags.TestCmdPluralize) {
+		fmt.Fprintf(w, "Pluralize(%s, %d, %t) => %s\n", word, count, inclusive, pluralize.Pluralize(word, count, inclusive))
+	}
+}
+
+// exitOnError -- print the error and exit with a non-zero exit code.
+func exitOnError(err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", appName, err)
+		os.Exit(1)
 	}
 }
 
-func displayVersionInfo(name string) {
-	fmt.Fprintf(os.Stdout, "%s - %s\n",
+func displayVersionInfo(w io.Writer, name string) {
+	fmt.Fprintf(w, "%s - %s\n",
 		name,
 		version.GetInfo(),
 	)
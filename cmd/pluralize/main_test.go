@@ -0,0 +1,131 @@
+package main //nolint:testpackage
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeFile -- write a test file to the test temp dir, returning its path.
+func writeFile(t *testing.T, name string, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("FAIL write %s %v", path, err)
+	}
+
+	return path
+}
+
+func TestRun(t *testing.T) { //nolint:funlen
+	words := writeFile(t, "words.txt", "Cactus\r\nBoxes\n")
+	rules := writeFile(t, "custom.rules", "plural (?i)(octop)us$ $1odes\nuncountable widget\n")
+	badRules := writeFile(t, "bad.rules", "# bad\nplural (?i)(x)$ $2\nbogus word\n")
+	tableWords := writeFile(t, "table.txt", "box\n\nthou\n")
+
+	tests := []struct {
+		args     []string
+		expected string
+		err      string
+	}{
+		// Subcommands.
+		{[]string{"plural", "cactus", "Box"}, "cacti\nBoxes\n", ``},
+		{[]string{"singular", "cacti", "people"}, "cactus\nperson\n", ``},
+		{[]string{"is-plural", "boxes", "geese"}, "true\ntrue\n", ``},
+		{[]string{"is-plural", "boxes", "box"}, "true\nfalse\n", errExit.Error()},
+		{[]string{"is-singular", "-quiet", "box"}, ``, ``},
+		{[]string{"is-singular", "-quiet", "boxes"}, ``, errExit.Error()},
+		{[]string{"count", "3", "duck"}, "ducks\n", ``},
+		{[]string{"count", "-inclusive", "1", "duck"}, "1 duck\n", ``},
+		{[]string{"count", "x", "duck"}, ``, `count: invalid count "x"`},
+		{[]string{"count"}, ``, `count: count not specified`},
+		{[]string{"explain", "-output", "json", "-word", "goose"}, `{"word":"goose","plural":{"result":"geese","stage":"irregular"},"singular":{"result":"goose","stage":"keep"}}` + "\n", ``},                                                     //nolint:lll
+		{[]string{"explain", "-output", "json", "sheep"}, `{"word":"sheep","plural":{"result":"sheep","stage":"uncountable","expression":"(?i)sheep$"},"singular":{"result":"sheep","stage":"uncountable","expression":"(?i)sheep$"}}` + "\n", ``}, //nolint:lll
+		{[]string{"explain", "-output", "xml", "goose"}, ``, `explain: unknown -output value xml, valid [text|json]`},
+		{[]string{"bogus"}, ``, errExit.Error()},
+		{[]string{}, ``, errExit.Error()},
+		{[]string{"plural", "-nope"}, ``, errExit.Error()},
+		{[]string{"help", "bogus"}, ``, `unknown command "bogus"`},
+		// Rule files.
+		{[]string{"plural", "-rules", rules, "octopus", "widget"}, "octopodes\nwidget\n", ``},
+		{[]string{"plural", "-rules", badRules, "box"}, ``, badRules + `:2: replacement $2 references $2, rule (?i)(x)$ has 1 capture groups` + "\n" + badRules + `:3: unknown rule kind "bogus", valid [plural|singular|irregular|uncountable]`}, //nolint:lll
+		{[]string{"plural", "-rules", filepath.Join(t.TempDir(), "missing.rules"), "box"}, ``, `no such file or directory`},
+		// Table.
+		{[]string{"table", "-color", "never", "box", "cactus"}, "WORD    PLURAL  SINGULAR  ROUND-TRIP\nbox     boxes   box       ok\ncactus  cacti   cactus    ok\n\n2 words, 0 inconsistent\n", ``},                          //nolint:lll
+		{[]string{"table", "-color", "never", "-failed", "-file", tableWords}, "WORD  PLURAL  SINGULAR  ROUND-TRIP\nthou  you     thou      FAIL Singular(Plural(thou)) = you\n\n2 words, 1 inconsistent\n", errExit.Error()}, //nolint:lll
+		{[]string{"table", "-color", "red", "box"}, ``, `table: unknown -color value red, valid [auto|always|never]`},
+		// Flags.
+		{[]string{"-word", "Cactus", "-cmd", "Plural"}, "Plural(Cactus)     => Cacti\n", ``},
+		{[]string{"-word", "Duck", "-cmd", "Pluralize", "-count", "3", "-inclusive"}, "Pluralize(Duck, 3, true) => 3 Ducks\n", ``},
+		{[]string{"-cmd", "Plural"}, ``, `-word not specified`},
+		{[]string{"-word", "a", "-cmd", "Bogus"}, ``, `unknown -cmd value Bogus, valid [All|IsPlural|IsSingular|Plural|Singular|Pluralize]`}, //nolint:lll
+		{[]string{"-batch", "-cmd", "Plural", words}, "Cacti\nBoxes\n", ``},
+		{[]string{"-batch", words}, "false\ttrue\tCacti\tCactus\tCactus\ntrue\tfalse\tBoxes\tBox\tBox\n", ``},
+		{[]string{"-word", "box", "-output", "json", "-count", "2", "-inclusive"}, `{"input":"box","plural":"boxes","singular":"box","isPlural":false,"isSingular":true,"count":2,"pluralize":"2 boxes"}` + "\n", ``}, //nolint:lll
+		{[]string{"-batch", "-output", "csv", words}, "input,plural,singular,isPlural,isSingular,count,pluralize\nCactus,Cacti,Cactus,false,true,1,Cactus\nBoxes,Boxes,Box,true,false,1,Box\n", ``},                   //nolint:lll
+		{[]string{"-word", "box", "-output", "tsv"}, "input\tplural\tsingular\tisPlural\tisSingular\tcount\tpluralize\nbox\tboxes\tbox\tfalse\ttrue\t1\tbox\n", ``},                                                   //nolint:lll
+		{[]string{"-word", "box", "-output", "xml"}, ``, `unknown -output value xml, valid [text|json|csv|tsv]`},
+		{[]string{"-word", "box", "-rules", badRules}, ``, badRules + `:2:`},
+	}
+
+	for i, test := range tests {
+		var out bytes.Buffer
+
+		err := run(&out, test.args)
+
+		switch {
+		case len(test.err) == 0 && err != nil:
+			t.Errorf("FAIL test[%d] %v unexpected error %v", i, test.args, err)
+		case len(test.err) > 0 && (err == nil || !strings.Contains(err.Error(), test.err)):
+			t.Errorf("FAIL test[%d] %v expected error %s, actual %v", i, test.args, test.err, err)
+		case test.err == errExit.Error() && !errors.Is(err, errExit):
+			t.Errorf("FAIL test[%d] %v expected errExit, actual %v", i, test.args, err)
+		}
+
+		if actual := out.String(); actual != test.expected {
+			t.Errorf("FAIL test[%d] %v expected %q, actual %q", i, test.args, test.expected, actual)
+		}
+	}
+}
+
+func TestRunRuleColumns(t *testing.T) {
+	var out bytes.Buffer
+
+	if err := run(&out, []string{"-word", "box", "-output", "csv", "-rule"}); err != nil {
+		t.Fatalf("FAIL run %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 2 || !strings.HasSuffix(lines[0], ",pluralRule,singularRule") ||
+		!strings.Contains(lines[1], ",rule ") {
+		t.Errorf("FAIL -rule columns %q", out.String())
+	}
+}
+
+func TestRunHelp(t *testing.T) {
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{"help"}, "Usage: pluralize <command> [flags] [word ...]\n"},
+		{[]string{"help", "plural"}, "Usage: pluralize plural [flags] [word ...]\n"},
+		{[]string{"help", "serve"}, "Usage: pluralize serve [flags]\n"},
+	}
+
+	for i, test := range tests {
+		var out bytes.Buffer
+
+		if err := run(&out, test.args); err != nil && !errors.Is(err, flag.ErrHelp) {
+			t.Errorf("FAIL test[%d] %v unexpected error %v", i, test.args, err)
+		}
+
+		if actual := out.String(); !strings.HasPrefix(actual, test.expected) || !strings.Contains(actual, "\n\n") {
+			t.Errorf("FAIL test[%d] %v expected help on the output writer, actual %q", i, test.args, actual)
+		}
+	}
+}
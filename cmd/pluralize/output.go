@@ -0,0 +1,127 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// Output formats.
+const (
+	outputText = "text"
+	outputJSON = "json"
+	outputCSV  = "csv"
+	outputTSV  = "tsv"
+)
+
+// record -- structured result for a word.
+type record struct {
+	Input        string `json:"input"`
+	Plural       string `json:"plural"`
+	Singular     string `json:"singular"`
+	IsPlural     bool   `json:"isPlural"`
+	IsSingular   bool   `json:"isSingular"`
+	Count        int    `json:"count"`
+	Pluralize    string `json:"pluralize"`
+	PluralRule   string `json:"pluralRule,omitempty"`
+	SingularRule string `json:"singularRule,omitempty"`
+}
+
+func newRecord(client *pluralize.Client, word string, count int, inclusive bool, withRule bool) record {
+	r := record{
+		Input:      word,
+		Plural:     client.Plural(word),
+		Singular:   client.Singular(word),
+		IsPlural:   client.IsPlural(word),
+		IsSingular: client.IsSingular(word),
+		Count:      count,
+		Pluralize:  client.Pluralize(word, count, inclusive),
+	}
+
+	if withRule {
+		r.PluralRule = client.TracePlural(word).String()
+		r.SingularRule = client.TraceSingular(word).String()
+	}
+
+	return r
+}
+
+// recordWriter -- writes records in a structured output format.
+type recordWriter interface {
+	Write(r record) error
+	Flush() error
+}
+
+// newRecordWriter -- record writer factory method, JSON output is written as one object per line.
+func newRecordWriter(format string, w io.Writer, withRule bool) (recordWriter, error) {
+	switch strings.ToLower(format) {
+	case outputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+
+		return &jsonWriter{enc: enc}, nil
+	case outputCSV:
+		return &csvWriter{w: csv.NewWriter(w), withRule: withRule}, nil
+	case outputTSV:
+		cw := csv.NewWriter(w)
+		cw.Comma = '\t'
+
+		return &csvWriter{w: cw, withRule: withRule}, nil
+	default:
+		return nil, fmt.Errorf("unknown -output value %s, valid [%s|%s|%s|%s]", format,
+			outputText, outputJSON, outputCSV, outputTSV)
+	}
+}
+
+type jsonWriter struct {
+	enc *json.Encoder
+}
+
+func (j *jsonWriter) Write(r record) error {
+	return j.enc.Encode(r)
+}
+
+func (j *jsonWriter) Flush() error {
+	return nil
+}
+
+type csvWriter struct {
+	w        *csv.Writer
+	withRule bool
+	header   bool
+}
+
+func (c *csvWriter) Write(r record) error {
+	if !c.header {
+		c.header = true
+
+		header := []string{"input", "plural", "singular", "isPlural", "isSingular", "count", "pluralize"}
+		if c.withRule {
+			header = append(header, "pluralRule", "singularRule")
+		}
+
+		if err := c.w.Write(header); err != nil {
+			return err
+		}
+	}
+
+	fields := []string{
+		r.Input, r.Plural, r.Singular, strconv.FormatBool(r.IsPlural), strconv.FormatBool(r.IsSingular),
+		strconv.Itoa(r.Count), r.Pluralize,
+	}
+	if c.withRule {
+		fields = append(fields, r.PluralRule, r.SingularRule)
+	}
+
+	return c.w.Write(fields)
+}
+
+func (c *csvWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
+const replPrompt = "> "
+
+const replHelp = `Enter a word to evaluate it, or a command:
+  :plural <rule> <replacement>     add a plural rule
+  :singular <rule> <replacement>   add a singular rule
+  :irregular <single> <plural>     add an irregular word
+  :uncountable <word or rule>      add an uncountable word
+  :explain <word>                  show the stage or rule resolving the word
+  :rules                           list the rules added in this session
+  :save <file>                     save the rules added in this session to a rule file
+  :help                            show this help
+  :quit                            exit
+Fields may be double quoted to contain white space or be empty.
+`
+
+// repl -- interactive session, rules holds the rules added in the session in rule file syntax.
+type repl struct {
+	client *pluralize.Client
+	w      io.Writer
+	rules  []string
+}
+
+func runRepl(cmd *command, w io.Writer, args []string) error {
+	fs := cmd.flagSet()
+
+	client, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	r := repl{client: client, w: w}
+
+	return r.run(os.Stdin, isTerminal(os.Stdin))
+}
+
+// isTerminal -- the file is a character device, prompts are only shown for interactive input.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+func (r *repl) run(in io.Reader, interactive bool) error {
+	if interactive {
+		fmt.Fprintf(r.w, "%s repl, :help for help\n", appName)
+	}
+
+	scanner := bufio.NewScanner(in)
+
+	for {
+		if interactive {
+			fmt.Fprint(r.w, replPrompt)
+		}
+
+		if flusher, ok := r.w.(interface{ Flush() error }); ok {
+			if err := flusher.Flush(); err != nil {
+				return err
+			}
+		}
+
+		if !scanner.Scan() {
+			if interactive {
+				fmt.Fprintln(r.w)
+			}
+
+			return scanner.Err()
+		}
+
+		err := r.eval(strings.TrimSpace(scanner.Text()))
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			fmt.Fprintf(r.w, "error: %v\n", err)
+		}
+	}
+}
+
+// eval -- evaluate a line, io.EOF ends the session.
+func (r *repl) eval(line string) error {
+	if len(line) == 0 {
+		return nil
+	}
+
+	if !strings.HasPrefix(line, ":") {
+		r.evalWord(line)
+		return nil
+	}
+
+	name, arg := line[1:], ``
+	if i := strings.IndexAny(name, " \t"); i >= 0 {
+		name, arg = name[:i], strings.TrimSpace(name[i:])
+	}
+
+	switch name {
+	case pluralize.RuleKindPlural, pluralize.RuleKindSingular, pluralize.RuleKindIrregular, pluralize.RuleKindUncountable:
+		return r.addRule(line[1:])
+	case "explain":
+		if len(arg) == 0 {
+			return fmt.Errorf(":explain word not specified")
+		}
+
+		return printExplain(r.w, r.client, arg)
+	case "rules":
+		for _, rule := range r.rules {
+			fmt.Fprintln(r.w, rule)
+		}
+	case "save":
+		if len(arg) == 0 {
+			return fmt.Errorf(":save file not specified")
+		}
+
+		return r.save(arg)
+	case "help":
+		fmt.Fprint(r.w, replHelp)
+	case "quit", "q", "exit":
+		return io.EOF
+	default:
+		return fmt.Errorf("unknown command :%s, :help for help", name)
+	}
+
+	return nil
+}
+
+func (r *repl) evalWord(word string) {
+	fmt.Fprintf(r.w, "plural: %s  singular: %s  isPlural: %t  isSingular: %t\n",
+		r.client.Plural(word), r.client.Singular(word), r.client.IsPlural(word), r.client.IsSingular(word))
+}
+
+// addRule -- validate and add a rule in rule file syntax.
+func (r *repl) addRule(rule string) error {
+	if err := r.client.LoadRules(strings.NewReader(rule)); err != nil {
+		var errs pluralize.RuleErrors
+		if errors.As(err, &errs) && len(errs) == 1 {
+			return errs[0].Err
+		}
+
+		return err
+	}
+
+	r.rules = append(r.rules, rule)
+
+	return nil
+}
+
+// save -- write the session rules to a rule file, loadable with -rules.
+func (r *repl) save(file string) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s repl session rules\n", appName)
+
+	for _, rule := range r.rules {
+		sb.WriteString(rule)
+		sb.WriteByte('\n')
+	}
+
+	if err := os.WriteFile(file, []byte(sb.String()), 0o644); err != nil { //nolint:gosec
+		return err
+	}
+
+	fmt.Fprintf(r.w, "saved %d rules to %s\n", len(r.rules), file)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package main //nolint:testpackage
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gertd/go-pluralize"
+)
+
+func TestRepl(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "session.rules")
+
+	script := strings.Join([]string{
+		`octopus`,
+		`:plural (?i)(octop)us$ $1odes`,
+		`:singular "(?i)(octop)odes$" $1us`,
+		`:plural (?i)(x)$ $2`,
+		`box`,
+		`:irregular ox`,
+		`:uncountable widget`,
+		``,
+		`octopus`,
+		`widget`,
+		`:explain octopodes`,
+		`:rules`,
+		`:save ` + file,
+		`:bogus`,
+		`:quit`,
+		`never evaluated`,
+	}, "\n")
+
+	var out bytes.Buffer
+
+	r := repl{client: pluralize.NewClient(), w: &out}
+	if err := r.run(strings.NewReader(script), false); err != nil {
+		t.Fatalf("FAIL run %v", err)
+	}
+
+	expected := []string{
+		`plural: octopuses  singular: octopus  isPlural: false  isSingular: true`,
+		`error: replacement $2 references $2, rule (?i)(x)$ has 1 capture groups`,
+		`plural: boxes  singular: box  isPlural: false  isSingular: true`,
+		`error: irregular rule expects 2 fields, got 1`,
+		`plural: octopodes  singular: octopus  isPlural: false  isSingular: true`,
+		`plural: widget  singular: widget  isPlural: true  isSingular: true`,
+		`octopodes`,
+		`  singular: octopus`,
+		`    captures:    $1="octop"`,
+		"plural (?i)(octop)us$ $1odes\nsingular \"(?i)(octop)odes$\" $1us\nuncountable widget\n",
+		`saved 3 rules to ` + file,
+		`error: unknown command :bogus, :help for help`,
+	}
+
+	actual := out.String()
+	pos := 0
+
+	for i, e := range expected {
+		n := strings.Index(actual[pos:], e)
+		if n < 0 {
+			t.Fatalf("FAIL expected[%d] %q in order, actual\n%s", i, e, actual)
+		}
+
+		pos += n + len(e)
+	}
+
+	if strings.Contains(actual, `never evaluated`) || strings.Contains(actual, `plural: never`) {
+		t.Errorf("FAIL input after :quit evaluated\n%s", actual)
+	}
+
+	// The saved session loads into a new client.
+	f, err := os.Open(file)
+	if err != nil {
+		t.Fatalf("FAIL open %v", err)
+	}
+	defer f.Close()
+
+	client := pluralize.NewClient()
+	if err := client.LoadRules(f); err != nil {
+		t.Fatalf("FAIL LoadRules %v", err)
+	}
+
+	if actual := client.Plural(`octopus`); actual != `octopodes` {
+		t.Errorf("FAIL Plural(octopus) expected octopodes, actual %s", actual)
+	}
+}
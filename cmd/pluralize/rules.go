@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// fileList -- repeatable file path flag.
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fileList) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// loadRuleFiles -- load the rule files into the client, in command line order.
+func loadRuleFiles(client *pluralize.Client, files []string) error {
+	for _, file := range files {
+		if err := loadRuleFile(client, file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func loadRuleFile(client *pluralize.Client, file string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := client.LoadRules(f); err != nil {
+		if errs, ok := err.(pluralize.RuleErrors); ok { //nolint:errorlint
+			s := make([]string, len(errs))
+			for i, e := range errs {
+				s[i] = fmt.Sprintf("%s:%d: %v", file, e.Line, e.Err)
+			}
+
+			return fmt.Errorf("%s", strings.Join(s, "\n"))
+		}
+
+		return fmt.Errorf("%s: %w", file, err)
+	}
+
+	return nil
+}
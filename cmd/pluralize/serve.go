@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/gertd/go-pluralize/pkg/grpcapi"
+	"github.com/gertd/go-pluralize/pkg/grpcapi/pluralizepb"
+	"github.com/gertd/go-pluralize/pkg/httpapi"
+	"google.golang.org/grpc"
+)
+
+func runServe(cmd *command, w io.Writer, args []string) error {
+	fs := cmd.flagSet()
+	addr := fs.String("addr", ":8080", "listen address")
+	grpcAddr := fs.String("grpc-addr", "", "gRPC listen address, the gRPC service is disabled when empty")
+	maxBody := fs.Int64("max-body", httpapi.DefaultMaxBodyBytes, "maximum request body size in bytes")
+	maxBatch := fs.Int("max-batch", httpapi.DefaultMaxBatchSize, "maximum number of items in a batch request")
+	shutdown := fs.Duration("shutdown-timeout", 10*time.Second, "time to wait for active requests on shutdown")
+
+	client, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	api := httpapi.NewServer(client)
+	api.MaxBodyBytes = *maxBody
+	api.MaxBatchSize = *maxBatch
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           api,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       2 * time.Minute,
+		ErrorLog:          log.New(os.Stderr, appName+": ", 0),
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		return err
+	}
+
+	errc := make(chan error, 1)
+
+	go func() {
+		errc <- srv.Serve(ln)
+	}()
+
+	fmt.Fprintf(os.Stderr, "%s: listening on %s\n", appName, ln.Addr())
+
+	var (
+		gs    *grpc.Server
+		gerrc = make(chan error, 1)
+	)
+
+	if len(*grpcAddr) > 0 {
+		gln, err := net.Listen("tcp", *grpcAddr)
+		if err != nil {
+			srv.Close()
+			return err
+		}
+
+		gs = grpc.NewServer()
+		pluralizepb.RegisterPluralizeServer(gs, grpcapi.NewServer(client))
+
+		go func() {
+			gerrc <- gs.Serve(gln)
+		}()
+
+		fmt.Fprintf(os.Stderr, "%s: gRPC listening on %s\n", appName, gln.Addr())
+	}
+
+	select {
+	case err = <-errc:
+	case err = <-gerrc:
+	case <-ctx.Done():
+	}
+
+	fmt.Fprintf(os.Stderr, "%s: shutting down\n", appName)
+
+	sctx, cancel := context.WithTimeout(context.Background(), *shutdown)
+	defer cancel()
+
+	if gs != nil {
+		go func() {
+			<-sctx.Done()
+			gs.Stop()
+		}()
+
+		gs.GracefulStop()
+	}
+
+	if shutdownErr := srv.Shutdown(sctx); err == nil {
+		err = shutdownErr
+	}
+
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+
+	return err
+}
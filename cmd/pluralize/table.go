@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// ANSI escape sequences used to highlight inconsistent rows.
+const (
+	colorRed   = "\033[31;01m"
+	colorReset = "\033[0m"
+)
+
+// Color modes.
+const (
+	colorAuto   = "auto"
+	colorAlways = "always"
+	colorNever  = "never"
+)
+
+// tableRow -- table columns of a word, problem describes the inconsistency, empty when round-trip OK.
+type tableRow struct {
+	word     string
+	plural   string
+	singular string
+	problem  string
+}
+
+// newTableRow -- inflect word, checking Singular(Plural(w)) == w for singular words
+// and Plural(Singular(w)) == w for plural words.
+func newTableRow(client *pluralize.Client, word string) tableRow {
+	r := tableRow{word: word, plural: client.Plural(word), singular: client.Singular(word)}
+
+	if client.IsSingular(word) {
+		if back := client.Singular(r.plural); back != word {
+			r.problem = fmt.Sprintf("Singular(Plural(%s)) = %s", word, back)
+			return r
+		}
+	}
+
+	if client.IsPlural(word) {
+		if back := client.Plural(r.singular); back != word {
+			r.problem = fmt.Sprintf("Plural(Singular(%s)) = %s", word, back)
+		}
+	}
+
+	return r
+}
+
+func (r tableRow) columns() []string {
+	roundTrip := "ok"
+	if len(r.problem) > 0 {
+		roundTrip = "FAIL " + r.problem
+	}
+
+	return []string{r.word, r.plural, r.singular, roundTrip}
+}
+
+func runTable(cmd *command, w io.Writer, args []string) error {
+	fs := cmd.flagSet()
+	color := fs.String("color", colorAuto, "highlight inconsistent rows [auto|always|never]")
+	failedOnly := fs.Bool("failed", false, "only print inconsistent rows")
+
+	var files fileList
+
+	fs.Var(&files, "file", "read the word list from file, one word per line (repeatable)")
+
+	client, err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	var highlight bool
+
+	switch strings.ToLower(*color) {
+	case colorAuto:
+		highlight = isTerminal(os.Stdout)
+	case colorAlways:
+		highlight = true
+	case colorNever:
+	default:
+		return fmt.Errorf("%s: unknown -color value %s, valid [%s|%s|%s]", cmd.name, *color,
+			colorAuto, colorAlways, colorNever)
+	}
+
+	var rows []tableRow
+
+	add := func(word string) error {
+		if word = strings.TrimSpace(word); len(word) > 0 {
+			rows = append(rows, newTableRow(client, word))
+		}
+
+		return nil
+	}
+
+	if len(files) > 0 {
+		err = forEachLine(files, add)
+	} else {
+		err = forEachWord(fs.Args(), add)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	failed := printTable(w, rows, *failedOnly, highlight)
+
+	fmt.Fprintf(w, "\n%d words, %d inconsistent\n", len(rows), failed)
+
+	if failed > 0 {
+		return errExit
+	}
+
+	return nil
+}
+
+// printTable -- print the rows with aligned columns, returning the number of inconsistent rows.
+func printTable(w io.Writer, rows []tableRow, failedOnly bool, highlight bool) int {
+	var (
+		shown  []tableRow
+		failed int
+	)
+
+	for _, r := range rows {
+		if len(r.problem) > 0 {
+			failed++
+		} else if failedOnly {
+			continue
+		}
+
+		shown = append(shown, r)
+	}
+
+	header := []string{"WORD", "PLURAL", "SINGULAR", "ROUND-TRIP"}
+
+	widths := make([]int, len(header))
+	for i, h := range header {
+		widths[i] = displayWidth(h)
+	}
+
+	for _, r := range shown {
+		for i, c := range r.columns() {
+			if n := displayWidth(c); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+
+	line := func(columns []string) string {
+		var sb strings.Builder
+
+		for i, c := range columns {
+			sb.WriteString(c)
+
+			if i < len(columns)-1 {
+				sb.WriteString(strings.Repeat(" ", widths[i]-displayWidth(c)+2))
+			}
+		}
+
+		return sb.String()
+	}
+
+	fmt.Fprintln(w, line(header))
+
+	for _, r := range shown {
+		if len(r.problem) > 0 && highlight {
+			fmt.Fprintln(w, colorRed+line(r.columns())+colorReset)
+			continue
+		}
+
+		fmt.Fprintln(w, line(r.columns()))
+	}
+
+	return failed
+}
+
+// displayWidth -- terminal width of s, East Asian wide characters take two cells.
+func displayWidth(s string) int {
+	n := 0
+
+	for _, r := range s {
+		n++
+
+		if unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul) {
+			n++
+		}
+	}
+
+	return n
+}
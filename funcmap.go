@@ -0,0 +1,42 @@
+package pluralize
+
+// FuncMap -- template functions bound to a client, usable with both text/template and html/template.
+//
+//	plural word                     Plural
+//	singular word                   Singular
+//	isPlural word                   IsPlural
+//	isSingular word                 IsSingular
+//	pluralize count word            Pluralize, not inclusive (e.g. files)
+//	pluralizeInclusive count word   Pluralize, inclusive (e.g. 3 files)
+//	pluralizeArticle count word     PluralizeArticle (e.g. a file, 3 files)
+//	article word                    Article
+//	possessive word                 Possessive
+//	pluralPossessive word           PluralPossessive
+//	singularPossessive word         SingularPossessive
+//	agree count noun verb           Agree
+//
+// The word is the last argument so functions can be used in pipelines (e.g. {{"file" | pluralize .Count}}).
+func FuncMap(c *Client) map[string]interface{} {
+	return map[string]interface{}{
+		"plural":     c.Plural,
+		"singular":   c.Singular,
+		"isPlural":   c.IsPlural,
+		"isSingular": c.IsSingular,
+		"pluralize": func(count int, word string) string {
+			return c.Pluralize(word, count, false)
+		},
+		"pluralizeInclusive": func(count int, word string) string {
+			return c.Pluralize(word, count, true)
+		},
+		"pluralizeArticle": func(count int, word string) string {
+			return c.PluralizeArticle(word, count)
+		},
+		"article":            c.Article,
+		"possessive":         c.Possessive,
+		"pluralPossessive":   c.PluralPossessive,
+		"singularPossessive": c.SingularPossessive,
+		"agree": func(count int, noun string, verb string) string {
+			return c.Agree(count, noun, verb)
+		},
+	}
+}
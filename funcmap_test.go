@@ -0,0 +1,84 @@
+package pluralize //nolint:testpackage
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+)
+
+type templateTest struct {
+	src      string
+	data     interface{}
+	expected string
+}
+
+func funcMapTests() []templateTest {
+	return []templateTest{
+		{`{{plural "file"}}`, nil, `files`},
+		{`{{singular "Children"}}`, nil, `Child`},
+		{`{{isPlural "files"}} {{isSingular "files"}}`, nil, `true false`},
+		{`{{pluralize .N "file"}}`, map[string]int{"N": 1}, `file`},
+		{`{{"file" | pluralize .N}}`, map[string]int{"N": 2}, `files`},
+		{`{{pluralizeInclusive .N "box"}}`, map[string]int{"N": 3}, `3 boxes`},
+		{`{{pluralizeArticle .N "hour"}}`, map[string]int{"N": 1}, `an hour`},
+		{`{{article "FAQ"}} FAQ`, nil, `an FAQ`},
+		{`{{agree .N "file" "was deleted"}}`, map[string]int{"N": 2}, `2 files were deleted`},
+	}
+}
+
+func TestFuncMapTextTemplate(t *testing.T) {
+	tests := append(funcMapTests(),
+		templateTest{`{{possessive "boss"}} {{pluralPossessive "child"}}`, nil, `boss's children's`},
+		templateTest{`{{singularPossessive "James"}}`, nil, `James's`},
+		templateTest{`{{plural .W}}`, map[string]string{"W": "<b>box</b>"}, `<b>box</b>s`},
+	)
+
+	funcs := FuncMap(NewClient())
+
+	for i, test := range tests {
+		tmpl, err := texttemplate.New("test").Funcs(funcs).Parse(test.src)
+		if err != nil {
+			t.Errorf("FAIL test[%d] Parse(%s) error %v", i, test.src, err)
+			continue
+		}
+
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, test.data); err != nil {
+			t.Errorf("FAIL test[%d] Execute(%s) error %v", i, test.src, err)
+			continue
+		}
+
+		if actual := sb.String(); actual != test.expected {
+			t.Errorf("FAIL test[%d] Execute(%s) expected %s, actual %s", i, test.src, test.expected, actual)
+		}
+	}
+}
+
+func TestFuncMapHTMLTemplate(t *testing.T) {
+	tests := append(funcMapTests(),
+		templateTest{`{{possessive "boss"}} {{pluralPossessive "child"}}`, nil, `boss&#39;s children&#39;s`},
+		templateTest{`{{plural .W}}`, map[string]string{"W": "<b>box</b>"}, `&lt;b&gt;box&lt;/b&gt;s`},
+		templateTest{`<a title="{{pluralizeInclusive .N "item"}}">`, map[string]int{"N": 2}, `<a title="2 items">`},
+	)
+
+	funcs := FuncMap(NewClient())
+
+	for i, test := range tests {
+		tmpl, err := htmltemplate.New("test").Funcs(funcs).Parse(test.src)
+		if err != nil {
+			t.Errorf("FAIL test[%d] Parse(%s) error %v", i, test.src, err)
+			continue
+		}
+
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, test.data); err != nil {
+			t.Errorf("FAIL test[%d] Execute(%s) error %v", i, test.src, err)
+			continue
+		}
+
+		if actual := sb.String(); actual != test.expected {
+			t.Errorf("FAIL test[%d] Execute(%s) expected %s, actual %s", i, test.src, test.expected, actual)
+		}
+	}
+}
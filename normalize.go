@@ -0,0 +1,51 @@
+package pluralize
+
+import (
+	"strings"
+)
+
+// Normalize -- Return the canonical lemma of a word, the lower cased singular (e.g. Dogs => dog).
+// Uncountable and irregular words are respected (e.g. sheep => sheep, Geese => goose).
+// Normalize(Plural(w)) == Normalize(w) holds for the singular - plural pairs of the built-in test corpus.
+func (c *Client) Normalize(word string) string {
+	return c.Singular(strings.ToLower(word))
+}
+
+// NormalizeTokens -- token filter applying Normalize to each token of a token stream,
+// the result has the same length and order as tokens.
+func (c *Client) NormalizeTokens(tokens []string) []string {
+	result := make([]string, len(tokens))
+
+	for i, t := range tokens {
+		result[i] = c.Normalize(t)
+	}
+
+	return result
+}
+
+// NormalizeText -- Tokenize text and return the normalized word tokens, including marked tokens.
+func (c *Client) NormalizeText(text string) []string {
+	var terms []string
+
+	for _, t := range Tokenize(text) {
+		switch t.Kind {
+		case TokenWord:
+			terms = append(terms, c.Normalize(t.Text))
+		case TokenMarked:
+			terms = append(terms, c.Normalize(strings.TrimSpace(t.Text)))
+		}
+	}
+
+	return terms
+}
+
+// Key -- Return a map key identifying a noun modulo number, case and surrounding spaces
+// (e.g. Key("Categories") == Key("category")).
+func (c *Client) Key(word string) string {
+	return c.Normalize(strings.TrimSpace(word))
+}
+
+// SameNoun -- Check if two words are the same noun modulo number and case (e.g. Category, categories).
+func (c *Client) SameNoun(a string, b string) bool {
+	return c.Key(a) == c.Key(b)
+}
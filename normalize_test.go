@@ -0,0 +1,114 @@
+package pluralize //nolint:testpackage
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []TestEntry{
+		{`dogs`, `dog`},
+		{`Dog`, `dog`},
+		{`DOGS`, `dog`},
+		{`Geese`, `goose`},
+		{`sheep`, `sheep`},
+		{`People`, `person`},
+		{`news`, `news`},
+		{``, ``},
+	}
+	passed := 0
+	failed := 0
+
+	pluralize := NewClient()
+
+	for i, testItem := range tests {
+		if actual := pluralize.Normalize(testItem.input); actual == testItem.expected {
+			plogf(t, "PASS test[%d] func %s(%s) expected %s, actual %s", i, "Normalize",
+				testItem.input, testItem.expected, actual)
+			passed++
+		} else {
+			t.Errorf("FAIL test[%d] func %s(%s) expected %s, actual %s", i, "Normalize",
+				testItem.input, testItem.expected, actual)
+			failed++
+		}
+	}
+
+	slog("TestNormalize", passed, failed, len(tests))
+}
+
+// TestNormalizeStability -- Normalize(Plural(w)) == Normalize(w) for the built-in corpus.
+// NOTE: pluralTests are excluded, they are one way singular to plural mappings (e.g. axis => axes).
+func TestNormalizeStability(t *testing.T) {
+	tests := append(basicTests(), singularTests()...)
+	passed := 0
+	failed := 0
+
+	pluralize := NewClient()
+
+	for i, testItem := range tests {
+		for _, w := range []string{testItem.input, testItem.expected} {
+			if expected, actual := pluralize.Normalize(w), pluralize.Normalize(pluralize.Plural(w)); actual == expected {
+				plogf(t, "PASS test[%d] Normalize(Plural(%s)) expected %s, actual %s", i, w, expected, actual)
+				passed++
+			} else {
+				t.Errorf("FAIL test[%d] Normalize(Plural(%s)) expected %s, actual %s", i, w, expected, actual)
+				failed++
+			}
+		}
+	}
+
+	slog("TestNormalizeStability", passed, failed, 2*len(tests))
+}
+
+func TestNormalizeTokens(t *testing.T) {
+	pluralize := NewClient()
+
+	actual := pluralize.NormalizeTokens([]string{`Dogs`, `and`, `CATS`, `mice`})
+	if expected := []string{`dog`, `and`, `cat`, `mouse`}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+
+	actual = pluralize.NormalizeText(`The dogs' toys, 3 [[Mice]]!`)
+	if expected := []string{`the`, `dog`, `toy`, `mouse`}; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, actual %v", expected, actual)
+	}
+}
+
+func TestSameNoun(t *testing.T) {
+	tests := []struct {
+		a        string
+		b        string
+		expected bool
+	}{
+		{`Category`, `categories`, true},
+		{`CATEGORIES`, ` category `, true},
+		{`person`, `People`, true},
+		{`Goose`, `geese`, true},
+		{`sheep`, `Sheep`, true},
+		{`news`, `News`, true},
+		{`category`, `catalog`, false},
+		{`person`, `persons`, true},
+		{`box`, `boxer`, false},
+	}
+
+	pluralize := NewClient()
+
+	for i, test := range tests {
+		if actual := pluralize.SameNoun(test.a, test.b); actual != test.expected {
+			t.Errorf("FAIL test[%d] SameNoun(%s, %s) expected %t, actual %t", i, test.a, test.b, test.expected, actual)
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	pluralize := NewClient()
+
+	tags := map[string]int{}
+	for _, tag := range []string{`Category`, `categories`, ` CATEGORY`, `Mouse`, `mice`, `fish`} {
+		tags[pluralize.Key(tag)]++
+	}
+
+	if expected := map[string]int{`category`: 3, `mouse`: 2, `fish`: 1}; !reflect.DeepEqual(tags, expected) {
+		t.Errorf("expected %v, actual %v", expected, tags)
+	}
+}
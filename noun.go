@@ -0,0 +1,106 @@
+package pluralize
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	defaultClient     *Client   //nolint:gochecknoglobals
+	defaultClientOnce sync.Once //nolint:gochecknoglobals
+)
+
+// Noun -- a word bound to a count and client, implements fmt.Formatter and fmt.Stringer.
+//
+// Supported verbs and flags:
+//
+//	%v %s  inclusive form (e.g. 3 files)
+//	%#v    word only (e.g. files)
+//	%+v    article instead of the count 1 (e.g. a file, 3 files)
+//	%S     as %s with the first letter capitalized (e.g. A file)
+//	%U     as %s upper cased (e.g. 3 FILES)
+//	%q     as %s double quoted
+//	%d     count only
+//
+// Width and the - flag pad the output (e.g. %-10v).
+type Noun struct {
+	client *Client
+	word   string
+	count  int
+}
+
+// Count -- bind a count and word using a shared default client (e.g. fmt.Printf("%v deleted", Count(n, "file"))).
+func Count(count int, word string) Noun {
+	return sharedClient().Count(count, word)
+}
+
+func sharedClient() *Client {
+	defaultClientOnce.Do(func() {
+		defaultClient = NewClient()
+	})
+
+	return defaultClient
+}
+
+// Count -- bind a count and word to the client.
+func (c *Client) Count(count int, word string) Noun {
+	return Noun{client: c, word: word, count: count}
+}
+
+// String -- inclusive form of the noun (e.g. 3 files).
+func (n Noun) String() string {
+	return n.pluralizer().Pluralize(n.word, n.count, true)
+}
+
+// pluralizer -- the bound client, the zero value Noun uses the shared default client.
+func (n Noun) pluralizer() *Client {
+	if n.client == nil {
+		return sharedClient()
+	}
+
+	return n.client
+}
+
+// Format -- implements fmt.Formatter.
+func (n Noun) Format(f fmt.State, verb rune) {
+	var s string
+
+	switch {
+	case f.Flag('#'):
+		s = n.pluralizer().Pluralize(n.word, n.count, false)
+	case f.Flag('+'):
+		s = n.pluralizer().PluralizeArticle(n.word, n.count)
+	default:
+		s = n.String()
+	}
+
+	switch verb {
+	case 'v', 's':
+	case 'S':
+		if len(s) > 0 {
+			s = strings.ToUpper(s[:1]) + s[1:]
+		}
+	case 'U':
+		s = strings.ToUpper(s)
+	case 'q':
+		s = strconv.Quote(s)
+	case 'd':
+		s = strconv.Itoa(n.count)
+	default:
+		fmt.Fprintf(f, "%%!%c(pluralize.Noun=%s)", verb, s)
+		return
+	}
+
+	format := `%`
+	if f.Flag('-') {
+		format += `-`
+	}
+
+	if width, ok := f.Width(); ok {
+		format += strconv.Itoa(width)
+	}
+
+	fmt.Fprintf(f, format+`s`, s)
+}
@@ -0,0 +1,38 @@
+package pluralize //nolint:testpackage
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNounFormat(t *testing.T) {
+	pluralize := NewClient()
+
+	tests := []TestEntry{
+		{fmt.Sprintf("%v deleted", Count(3, `file`)), `3 files deleted`},
+		{fmt.Sprintf("%v deleted", Count(1, `files`)), `1 file deleted`},
+		{fmt.Sprint(pluralize.Count(0, `child`)), `0 children`},
+		{pluralize.Count(2, `box`).String(), `2 boxes`},
+		{fmt.Sprintf("%s", pluralize.Count(2, `box`)), `2 boxes`},
+		{fmt.Sprintf("%#v", pluralize.Count(2, `box`)), `boxes`},
+		{fmt.Sprintf("%+v", pluralize.Count(1, `hour`)), `an hour`},
+		{fmt.Sprintf("%+v", pluralize.Count(2, `hour`)), `2 hours`},
+		{fmt.Sprintf("%+S", pluralize.Count(1, `file`)), `A file`},
+		{fmt.Sprintf("%#S", pluralize.Count(2, `file`)), `Files`},
+		{fmt.Sprintf("%U", pluralize.Count(2, `file`)), `2 FILES`},
+		{fmt.Sprintf("%q", pluralize.Count(2, `file`)), `"2 files"`},
+		{fmt.Sprintf("%d", pluralize.Count(2, `file`)), `2`},
+		{fmt.Sprintf("[%8v]", pluralize.Count(2, `file`)), `[ 2 files]`},
+		{fmt.Sprintf("[%-8v]", pluralize.Count(2, `file`)), `[2 files ]`},
+		{fmt.Sprintf("%x", pluralize.Count(2, `file`)), `%!x(pluralize.Noun=2 files)`},
+		// Without a client the shared default client is used.
+		{fmt.Sprint(Noun{word: `file`, count: 2}), `2 files`},
+		{fmt.Sprintf("%#v", Noun{}), ``},
+	}
+
+	for i, test := range tests {
+		if test.input != test.expected {
+			t.Errorf("FAIL test[%d] expected %s, actual %s", i, test.expected, test.input)
+		}
+	}
+}
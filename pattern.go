@@ -0,0 +1,95 @@
+package pluralize
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// neverMatch -- regular expression source which matches no text, not even the empty string.
+const neverMatch = `[^\x00-\x{10FFFF}]`
+
+// wordExpr -- matches a word character as defined by the \b assertion.
+var wordExpr = regexp.MustCompile(`^\w$`) //nolint:gochecknoglobals
+
+// MatchPattern -- Return a case-insensitive regular expression matching the singular, plural and
+// alternate regular plural forms of a word on word boundaries (e.g. entry => entry|entries).
+func (c *Client) MatchPattern(word string) *regexp.Regexp {
+	return regexp.MustCompile(c.MatchPatternString(word))
+}
+
+// MatchPatternString -- Return the regular expression source of MatchPattern
+// (e.g. cactus => (?i)(?:\bcactuses\b|\bcactus\b|\bcacti\b)).
+// An empty word has no forms and returns a pattern which never matches.
+func (c *Client) MatchPatternString(word string) string {
+	forms := c.nounForms(word)
+	if len(forms) == 0 {
+		return neverMatch
+	}
+
+	alternatives := make([]string, len(forms))
+	for i, f := range forms {
+		alternatives[i] = bounded(f)
+	}
+
+	if len(alternatives) == 1 {
+		return `(?i)` + alternatives[0]
+	}
+
+	return `(?i)(?:` + strings.Join(alternatives, `|`) + `)`
+}
+
+// bounded -- quoted word with word boundary assertions on its word character edges.
+func bounded(word string) string {
+	return boundary(word[:1]) + regexp.QuoteMeta(word) + boundary(word[len(word)-1:])
+}
+
+// boundary -- word boundary assertion when the edge of a word is a word character.
+func boundary(edge string) string {
+	if wordExpr.MatchString(edge) {
+		return `\b`
+	}
+
+	return ``
+}
+
+// nounForms -- unique lower cased singular, plural and alternate regular plural, longest first.
+func (c *Client) nounForms(word string) []string {
+	token := strings.ToLower(word)
+	singular, plural := c.Singular(token), c.Plural(token)
+
+	candidates := []string{singular, plural}
+	if singular != plural {
+		candidates = append(candidates, regularPlural(singular))
+	}
+
+	seen := map[string]bool{}
+	forms := []string{}
+
+	for _, f := range candidates {
+		if len(f) > 0 && !seen[f] {
+			seen[f] = true
+			forms = append(forms, f)
+		}
+	}
+
+	// Longest alternative first, the regexp alternation is leftmost first.
+	sort.SliceStable(forms, func(i, j int) bool {
+		return len(forms[i]) > len(forms[j])
+	})
+
+	return forms
+}
+
+// regularPlural -- plural following the regular English suffix rules (e.g. index => indexes, cactus => cactuses).
+func regularPlural(word string) string {
+	switch {
+	case strings.HasSuffix(word, `s`) || strings.HasSuffix(word, `x`) || strings.HasSuffix(word, `z`) ||
+		strings.HasSuffix(word, `ch`) || strings.HasSuffix(word, `sh`):
+		return word + `es`
+	case len(word) > 1 && strings.HasSuffix(word, `y`) && !strings.ContainsAny(word[len(word)-2:len(word)-1], `aeiou`):
+		return word[:len(word)-1] + `ies`
+	default:
+		return word + `s`
+	}
+}
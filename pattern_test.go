@@ -0,0 +1,52 @@
+package pluralize //nolint:testpackage
+
+import (
+	"testing"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		word     string
+		match    []string
+		notMatch []string
+	}{
+		{`entry`, []string{`entry`, `Entries`, `2 ENTRIES found`, `log entry:`}, []string{`entryway`, `reentry`, `entrys`}},
+		{`Entries`, []string{`entry`, `entries`}, nil},
+		{`cactus`, []string{`cactus`, `cacti`, `cactuses`}, []string{`cactu`}},
+		{`index`, []string{`index`, `indices`, `indexes`}, []string{`indexed`}},
+		{`person`, []string{`person`, `people`, `persons`}, []string{`personal`}},
+		{`sheep`, []string{`sheep`}, []string{`sheeps`}},
+		{`c++`, []string{`a c++ file`}, []string{`c`}},
+		{``, nil, []string{``, `any text`, "\x00\U0010FFFF"}},
+	}
+
+	pluralize := NewClient()
+
+	for i, test := range tests {
+		expr := pluralize.MatchPattern(test.word)
+
+		for _, s := range test.match {
+			if !expr.MatchString(s) {
+				t.Errorf("FAIL test[%d] %s expected match %s", i, expr, s)
+			}
+		}
+
+		for _, s := range test.notMatch {
+			if expr.MatchString(s) {
+				t.Errorf("FAIL test[%d] %s expected no match %s", i, expr, s)
+			}
+		}
+	}
+}
+
+func TestMatchPatternString(t *testing.T) {
+	pluralize := NewClient()
+
+	if actual := pluralize.MatchPatternString(`Cactus`); actual != `(?i)(?:\bcactuses\b|\bcactus\b|\bcacti\b)` {
+		t.Errorf("unexpected %s", actual)
+	}
+
+	if actual := pluralize.MatchPatternString(``); actual != neverMatch {
+		t.Errorf("unexpected %s", actual)
+	}
+}
@@ -0,0 +1,408 @@
+package gettext
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxExprLen -- maximum length of a plural expression.
+	maxExprLen = 1024
+	// maxExprDepth -- maximum nesting depth of a plural expression.
+	maxExprDepth = 64
+)
+
+// DefaultPluralForms -- English (germanic) plural forms, used when a catalog has no Plural-Forms header.
+const DefaultPluralForms = "nplurals=2; plural=(n != 1);"
+
+// PluralForms -- parsed Plural-Forms header value.
+type PluralForms struct {
+	NPlurals int
+	expr     *Expr
+	src      string
+}
+
+// ParsePluralForms -- parse a Plural-Forms header value (e.g. nplurals=2; plural=(n != 1);).
+func ParsePluralForms(s string) (*PluralForms, error) {
+	pf := PluralForms{src: s}
+
+	var plural string
+
+	for _, field := range strings.Split(s, `;`) {
+		field = strings.TrimSpace(field)
+		if len(field) == 0 {
+			continue
+		}
+
+		eq := strings.IndexByte(field, '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("gettext: invalid Plural-Forms field %q", field)
+		}
+
+		key, value := strings.TrimSpace(field[:eq]), strings.TrimSpace(field[eq+1:])
+
+		switch key {
+		case "nplurals":
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("gettext: invalid nplurals value %q", value)
+			}
+
+			pf.NPlurals = n
+		case "plural":
+			plural = value
+		default:
+			return nil, fmt.Errorf("gettext: unknown Plural-Forms field %q", key)
+		}
+	}
+
+	if pf.NPlurals == 0 {
+		return nil, fmt.Errorf("gettext: Plural-Forms %q missing nplurals", s)
+	}
+
+	if len(plural) == 0 {
+		return nil, fmt.Errorf("gettext: Plural-Forms %q missing plural", s)
+	}
+
+	expr, err := CompileExpr(plural)
+	if err != nil {
+		return nil, err
+	}
+
+	pf.expr = expr
+
+	return &pf, nil
+}
+
+// String -- return the Plural-Forms header value.
+func (pf *PluralForms) String() string {
+	return pf.src
+}
+
+// Index -- return the plural form index for count n, limited to [0, NPlurals).
+func (pf *PluralForms) Index(n int) int {
+	i := pf.expr.Eval(n)
+	if i < 0 || i >= pf.NPlurals {
+		return 0
+	}
+
+	return i
+}
+
+// Expr -- compiled C-like plural expression over the variable n.
+type Expr struct {
+	root *exprNode
+	src  string
+}
+
+// exprNode -- expression tree node, op is empty for literals and the variable n.
+type exprNode struct {
+	op       string
+	value    int
+	variable bool
+	args     []*exprNode
+}
+
+// CompileExpr -- compile a plural expression (e.g. n%10==1 && n%100!=11 ? 0 : 1).
+//
+// Supported are integer literals, the variable n, parentheses, the unary operators ! and -,
+// the binary operators * / % + - < <= > >= == != && || and the conditional operator ?:.
+func CompileExpr(s string) (*Expr, error) {
+	if len(s) > maxExprLen {
+		return nil, fmt.Errorf("gettext: plural expression exceeds %d bytes", maxExprLen)
+	}
+
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := exprParser{tokens: tokens}
+
+	root, err := p.parseTernary(0)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos != len(p.tokens) {
+		return nil, fmt.Errorf("gettext: unexpected %q in plural expression %q", p.tokens[p.pos], s)
+	}
+
+	return &Expr{root: root, src: s}, nil
+}
+
+// String -- return the expression source.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Eval -- evaluate the expression for count n, division by zero evaluates to 0.
+// Like gettext, which uses an unsigned count, negative counts are evaluated as their absolute value.
+func (e *Expr) Eval(n int) int {
+	if n < 0 {
+		n = -n
+	}
+
+	return e.root.eval(n)
+}
+
+func (x *exprNode) eval(n int) int { //nolint:gocyclo
+	switch {
+	case x.variable:
+		return n
+	case len(x.op) == 0:
+		return x.value
+	case x.op == `?`:
+		if x.args[0].eval(n) != 0 {
+			return x.args[1].eval(n)
+		}
+
+		return x.args[2].eval(n)
+	case x.op == `!`:
+		return boolInt(x.args[0].eval(n) == 0)
+	case x.op == `neg`:
+		return -x.args[0].eval(n)
+	case x.op == `&&`:
+		return boolInt(x.args[0].eval(n) != 0 && x.args[1].eval(n) != 0)
+	case x.op == `||`:
+		return boolInt(x.args[0].eval(n) != 0 || x.args[1].eval(n) != 0)
+	}
+
+	a, b := x.args[0].eval(n), x.args[1].eval(n)
+
+	switch x.op {
+	case `*`:
+		return a * b
+	case `/`:
+		if b == 0 {
+			return 0
+		}
+
+		return a / b
+	case `%`:
+		if b == 0 {
+			return 0
+		}
+
+		return a % b
+	case `+`:
+		return a + b
+	case `-`:
+		return a - b
+	case `<`:
+		return boolInt(a < b)
+	case `<=`:
+		return boolInt(a <= b)
+	case `>`:
+		return boolInt(a > b)
+	case `>=`:
+		return boolInt(a >= b)
+	case `==`:
+		return boolInt(a == b)
+	case `!=`:
+		return boolInt(a != b)
+	}
+
+	return 0
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func tokenize(s string) ([]string, error) {
+	var tokens []string
+
+	for i := 0; i < len(s); {
+		ch := s[i]
+
+		switch {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch >= '0' && ch <= '9':
+			j := i
+			for j < len(s) && s[j] >= '0' && s[j] <= '9' {
+				j++
+			}
+
+			tokens = append(tokens, s[i:j])
+			i = j
+		case ch == 'n':
+			tokens = append(tokens, `n`)
+			i++
+		default:
+			op := ``
+
+			for _, candidate := range []string{`&&`, `||`, `==`, `!=`, `<=`, `>=`, `<`, `>`, `!`, `?`, `:`, `(`, `)`, `*`, `/`, `%`, `+`, `-`} { //nolint:lll
+				if strings.HasPrefix(s[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+
+			if len(op) == 0 {
+				return nil, fmt.Errorf("gettext: invalid character %q in plural expression %q", ch, s)
+			}
+
+			tokens = append(tokens, op)
+			i += len(op)
+		}
+	}
+
+	return tokens, nil
+}
+
+// exprParser -- precedence climbing parser following the C operator precedence.
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+// binaryLevels -- binary operators from lowest to highest precedence.
+var binaryLevels = [][]string{ //nolint:gochecknoglobals
+	{`||`},
+	{`&&`},
+	{`==`, `!=`},
+	{`<`, `<=`, `>`, `>=`},
+	{`+`, `-`},
+	{`*`, `/`, `%`},
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+
+	return ``
+}
+
+func (p *exprParser) parseTernary(depth int) (*exprNode, error) {
+	if depth > maxExprDepth {
+		return nil, fmt.Errorf("gettext: plural expression nested deeper than %d", maxExprDepth)
+	}
+
+	cond, err := p.parseBinary(0, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek() != `?` {
+		return cond, nil
+	}
+
+	p.pos++
+
+	then, err := p.parseTernary(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek() != `:` {
+		return nil, fmt.Errorf("gettext: expected : in plural expression")
+	}
+
+	p.pos++
+
+	otherwise, err := p.parseTernary(depth + 1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exprNode{op: `?`, args: []*exprNode{cond, then, otherwise}}, nil
+}
+
+func (p *exprParser) parseBinary(level int, depth int) (*exprNode, error) {
+	if level == len(binaryLevels) {
+		return p.parseUnary(depth)
+	}
+
+	left, err := p.parseBinary(level+1, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := p.peek()
+		if !contains(binaryLevels[level], op) {
+			return left, nil
+		}
+
+		p.pos++
+
+		right, err := p.parseBinary(level+1, depth)
+		if err != nil {
+			return nil, err
+		}
+
+		left = &exprNode{op: op, args: []*exprNode{left, right}}
+	}
+}
+
+func (p *exprParser) parseUnary(depth int) (*exprNode, error) {
+	if depth > maxExprDepth {
+		return nil, fmt.Errorf("gettext: plural expression nested deeper than %d", maxExprDepth)
+	}
+
+	switch tok := p.peek(); {
+	case tok == `!` || tok == `-`:
+		p.pos++
+
+		arg, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if tok == `-` {
+			tok = `neg`
+		}
+
+		return &exprNode{op: tok, args: []*exprNode{arg}}, nil
+	case tok == `(`:
+		p.pos++
+
+		x, err := p.parseTernary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek() != `)` {
+			return nil, fmt.Errorf("gettext: expected ) in plural expression")
+		}
+
+		p.pos++
+
+		return x, nil
+	case tok == `n`:
+		p.pos++
+
+		return &exprNode{variable: true}, nil
+	case len(tok) > 0 && tok[0] >= '0' && tok[0] <= '9':
+		p.pos++
+
+		value, err := strconv.Atoi(tok)
+		if err != nil {
+			return nil, fmt.Errorf("gettext: invalid number %q in plural expression", tok)
+		}
+
+		return &exprNode{value: value}, nil
+	case len(tok) == 0:
+		return nil, fmt.Errorf("gettext: unexpected end of plural expression")
+	default:
+		return nil, fmt.Errorf("gettext: unexpected %q in plural expression", tok)
+	}
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
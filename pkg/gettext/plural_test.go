@@ -0,0 +1,125 @@
+package gettext //nolint:testpackage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPluralForms(t *testing.T) {
+	tests := []struct {
+		forms    string
+		counts   []int
+		expected []int
+	}{
+		// English.
+		{`nplurals=2; plural=(n != 1);`, []int{0, 1, 2, 5, 21}, []int{1, 0, 1, 1, 1}},
+		// French.
+		{`nplurals=2; plural=(n > 1);`, []int{0, 1, 2}, []int{0, 0, 1}},
+		// Japanese.
+		{`nplurals=1; plural=0;`, []int{0, 1, 2}, []int{0, 0, 0}},
+		// Russian.
+		{
+			`nplurals=3; plural=(n%10==1 && n%100!=11 ? 0 : n%10>=2 && n%10<=4 && (n%100<10 || n%100>=20) ? 1 : 2);`,
+			[]int{1, 2, 5, 11, 12, 21, 22, 25, 111, 112, -1},
+			[]int{0, 1, 2, 2, 2, 0, 1, 2, 2, 2, 0},
+		},
+		// Arabic.
+		{
+			`nplurals=6; plural=(n==0 ? 0 : n==1 ? 1 : n==2 ? 2 : n%100>=3 && n%100<=10 ? 3 : n%100>=11 ? 4 : 5);`,
+			[]int{0, 1, 2, 3, 11, 100, 102},
+			[]int{0, 1, 2, 3, 4, 5, 5},
+		},
+		// Out of range results select the first form.
+		{`nplurals=2; plural=n;`, []int{0, 1, 2, 3}, []int{0, 1, 0, 0}},
+	}
+
+	for i, test := range tests {
+		pf, err := ParsePluralForms(test.forms)
+		if err != nil {
+			t.Errorf("FAIL test[%d] ParsePluralForms(%s) error %v", i, test.forms, err)
+			continue
+		}
+
+		for j, n := range test.counts {
+			if actual := pf.Index(n); actual != test.expected[j] {
+				t.Errorf("FAIL test[%d] %s Index(%d) expected %d, actual %d", i, test.forms, n, test.expected[j], actual)
+			}
+		}
+	}
+}
+
+func TestEval(t *testing.T) {
+	tests := []struct {
+		expr     string
+		n        int
+		expected int
+	}{
+		{`n`, 7, 7},
+		{`1 + 2 * 3`, 0, 7},
+		{`(1 + 2) * 3`, 0, 9},
+		{`10 - 4 - 3`, 0, 3},
+		{`12 / 3 / 2`, 0, 2},
+		{`n / 0`, 5, 0},
+		{`n % 0`, 5, 0},
+		{`!n`, 0, 1},
+		{`!!n`, 3, 1},
+		{`-n + 10`, 3, 7},
+		{`n == 1 || n == 2`, 2, 1},
+		{`n > 1 && n < 3`, 3, 0},
+		{`n ? n ? 1 : 2 : 3`, 0, 3},
+		{`n >= 2 <= 1`, 5, 1},
+	}
+
+	for i, test := range tests {
+		e, err := CompileExpr(test.expr)
+		if err != nil {
+			t.Errorf("FAIL test[%d] CompileExpr(%s) error %v", i, test.expr, err)
+			continue
+		}
+
+		if actual := e.Eval(test.n); actual != test.expected {
+			t.Errorf("FAIL test[%d] %s Eval(%d) expected %d, actual %d", i, test.expr, test.n, test.expected, actual)
+		}
+	}
+}
+
+func TestCompileExprErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`n +`,
+		`(n`,
+		`n)`,
+		`n ? 1`,
+		`x == 1`,
+		`n = 1`,
+		`n; 1`,
+		`99999999999999999999999`,
+		strings.Repeat(`(`, 100) + `n` + strings.Repeat(`)`, 100),
+		strings.Repeat(`n+`, 600) + `n`,
+	}
+
+	for i, expr := range tests {
+		if _, err := CompileExpr(expr); err == nil {
+			t.Errorf("FAIL test[%d] CompileExpr(%s) expected error", i, expr)
+		}
+	}
+}
+
+func TestParsePluralFormsErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`nplurals=2;`,
+		`plural=(n != 1);`,
+		`nplurals=x; plural=(n != 1);`,
+		`nplurals=0; plural=0;`,
+		`nplurals=2; plural=(n != 1); extra=1;`,
+		`nplurals=2; plural`,
+		`nplurals=2; plural=(n !! 1);`,
+	}
+
+	for i, forms := range tests {
+		if _, err := ParsePluralForms(forms); err == nil {
+			t.Errorf("FAIL test[%d] ParsePluralForms(%s) expected error", i, forms)
+		}
+	}
+}
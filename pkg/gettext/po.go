@@ -0,0 +1,363 @@
+// Package gettext -- GNU gettext PO catalogs and Plural-Forms support.
+//
+// Catalogs are read from PO files, the Plural-Forms header of the catalog selects the
+// plural form for a count. Catalogs without a Plural-Forms header use the English rule
+// (n != 1), consistent with the count handling of Client.Pluralize.
+//
+//	cat, _ := gettext.ReadPO(f)
+//	cat.GetN("%d file", "%d files", 3) // "%d fichiers"
+package gettext
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// contextSeparator -- separates the message context from the message id in catalog keys.
+const contextSeparator = "\x04"
+
+// Entry -- PO catalog entry.
+type Entry struct {
+	Context  string
+	ID       string
+	IDPlural string
+	Str      []string
+}
+
+// Catalog -- PO message catalog.
+type Catalog struct {
+	Header      map[string]string
+	PluralForms *PluralForms
+	entries     map[string]*Entry
+}
+
+// NewCatalog -- catalog factory method, pluralForms may be empty to use DefaultPluralForms.
+func NewCatalog(pluralForms string) (*Catalog, error) {
+	if len(pluralForms) == 0 {
+		pluralForms = DefaultPluralForms
+	}
+
+	pf, err := ParsePluralForms(pluralForms)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Catalog{
+		Header:      map[string]string{},
+		PluralForms: pf,
+		entries:     map[string]*Entry{},
+	}, nil
+}
+
+// Add -- add or replace an entry in the catalog.
+func (c *Catalog) Add(e *Entry) {
+	c.entries[key(e.Context, e.ID)] = e
+}
+
+// Entry -- lookup an entry by context and message id.
+func (c *Catalog) Entry(context string, id string) (*Entry, bool) {
+	e, ok := c.entries[key(context, id)]
+	return e, ok
+}
+
+// Len -- number of entries in the catalog, excluding the header.
+func (c *Catalog) Len() int {
+	return len(c.entries)
+}
+
+// Get -- translate a message.
+func (c *Catalog) Get(id string) string {
+	return c.PGet(``, id)
+}
+
+// PGet -- translate a message in context.
+func (c *Catalog) PGet(context string, id string) string {
+	if e, ok := c.Entry(context, id); ok && len(e.Str) > 0 && len(e.Str[0]) > 0 {
+		return e.Str[0]
+	}
+
+	return id
+}
+
+// GetN -- translate a message with plural forms based on the passed in count.
+func (c *Catalog) GetN(id string, idPlural string, n int) string {
+	return c.PGetN(``, id, idPlural, n)
+}
+
+// PGetN -- translate a message in context with plural forms based on the passed in count.
+// Untranslated messages fall back to id when n is 1 and idPlural otherwise.
+func (c *Catalog) PGetN(context string, id string, idPlural string, n int) string {
+	if e, ok := c.Entry(context, id); ok {
+		if i := c.PluralForms.Index(n); i < len(e.Str) && len(e.Str[i]) > 0 {
+			return e.Str[i]
+		}
+	}
+
+	if n == 1 {
+		return id
+	}
+
+	return idPlural
+}
+
+// ReadPO -- read a catalog from a PO file.
+func ReadPO(r io.Reader) (*Catalog, error) {
+	c := Catalog{
+		Header:  map[string]string{},
+		entries: map[string]*Entry{},
+	}
+
+	p := poParser{scanner: bufio.NewScanner(r)}
+
+	for {
+		e, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+
+		if e == nil {
+			break
+		}
+
+		if len(e.ID) == 0 && len(e.Context) == 0 {
+			if len(e.Str) > 0 {
+				c.Header = parseHeader(e.Str[0])
+			}
+
+			continue
+		}
+
+		c.Add(e)
+	}
+
+	pluralForms := c.Header["Plural-Forms"]
+	if len(pluralForms) == 0 {
+		pluralForms = DefaultPluralForms
+	}
+
+	pf, err := ParsePluralForms(pluralForms)
+	if err != nil {
+		return nil, err
+	}
+
+	c.PluralForms = pf
+
+	for _, e := range c.entries {
+		if len(e.IDPlural) > 0 && len(e.Str) > pf.NPlurals {
+			return nil, fmt.Errorf("gettext: entry %q has %d plural forms, nplurals=%d", e.ID, len(e.Str), pf.NPlurals)
+		}
+	}
+
+	return &c, nil
+}
+
+func key(context string, id string) string {
+	if len(context) == 0 {
+		return id
+	}
+
+	return context + contextSeparator + id
+}
+
+func parseHeader(s string) map[string]string {
+	header := map[string]string{}
+
+	for _, line := range strings.Split(s, "\n") {
+		if i := strings.IndexByte(line, ':'); i > 0 {
+			header[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+		}
+	}
+
+	return header
+}
+
+// poParser -- line based PO file parser.
+type poParser struct {
+	scanner *bufio.Scanner
+	line    int
+	pending string
+	hasNext bool
+}
+
+// readLine -- next non-empty, non-comment line, empty at end of input.
+func (p *poParser) readLine() (string, error) {
+	if p.hasNext {
+		p.hasNext = false
+		return p.pending, nil
+	}
+
+	for p.scanner.Scan() {
+		p.line++
+
+		line := strings.TrimSpace(p.scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, `#`) {
+			continue
+		}
+
+		return line, nil
+	}
+
+	return ``, p.scanner.Err()
+}
+
+func (p *poParser) unreadLine(line string) {
+	p.pending = line
+	p.hasNext = true
+}
+
+func (p *poParser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("gettext: line %d: %s", p.line, fmt.Sprintf(format, a...))
+}
+
+// next -- parse the next entry, nil at end of input.
+func (p *poParser) next() (*Entry, error) { //nolint:gocyclo
+	var (
+		e     Entry
+		found bool
+	)
+
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(line) == 0 {
+			break
+		}
+
+		keyword, rest := line, ``
+		if i := strings.IndexAny(line, " \t"); i >= 0 {
+			keyword, rest = line[:i], strings.TrimSpace(line[i:])
+		}
+
+		// A msgctxt or msgid following a msgstr starts the next entry.
+		if found && len(e.Str) > 0 && (keyword == "msgctxt" || keyword == "msgid") {
+			p.unreadLine(line)
+			break
+		}
+
+		value, err := p.readString(rest)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case keyword == "msgctxt":
+			e.Context = value
+		case keyword == "msgid":
+			e.ID = value
+		case keyword == "msgid_plural":
+			e.IDPlural = value
+		case keyword == "msgstr":
+			e.Str = append(e.Str, value)
+		case strings.HasPrefix(keyword, "msgstr[") && strings.HasSuffix(keyword, "]"):
+			i, err := strconv.Atoi(keyword[len("msgstr[") : len(keyword)-1])
+			if err != nil || i != len(e.Str) {
+				return nil, p.errorf("invalid plural index %q", keyword)
+			}
+
+			e.Str = append(e.Str, value)
+		default:
+			return nil, p.errorf("unknown keyword %q", keyword)
+		}
+
+		found = true
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	if len(e.Str) == 0 {
+		return nil, p.errorf("entry %q has no msgstr", e.ID)
+	}
+
+	return &e, nil
+}
+
+// readString -- read a quoted string value and its continuation lines.
+func (p *poParser) readString(s string) (string, error) {
+	value, err := unquote(s)
+	if err != nil {
+		return ``, p.errorf("%v", err)
+	}
+
+	var sb strings.Builder
+
+	sb.WriteString(value)
+
+	for {
+		line, err := p.readLine()
+		if err != nil {
+			return ``, err
+		}
+
+		if !strings.HasPrefix(line, `"`) {
+			if len(line) > 0 {
+				p.unreadLine(line)
+			}
+
+			return sb.String(), nil
+		}
+
+		value, err := unquote(line)
+		if err != nil {
+			return ``, p.errorf("%v", err)
+		}
+
+		sb.WriteString(value)
+	}
+}
+
+func unquote(s string) (string, error) {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return ``, fmt.Errorf("invalid string %s", s)
+	}
+
+	var sb strings.Builder
+
+	for i := 1; i < len(s)-1; i++ {
+		ch := s[i]
+
+		if ch == '"' {
+			return ``, fmt.Errorf("unescaped quote in string %s", s)
+		}
+
+		if ch != '\\' {
+			sb.WriteByte(ch)
+			continue
+		}
+
+		i++
+		if i >= len(s)-1 {
+			return ``, fmt.Errorf("invalid escape in string %s", s)
+		}
+
+		switch s[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case 'a':
+			sb.WriteByte('\a')
+		case 'b':
+			sb.WriteByte('\b')
+		case 'f':
+			sb.WriteByte('\f')
+		case 'v':
+			sb.WriteByte('\v')
+		case '\\', '"', '\'', '?':
+			sb.WriteByte(s[i])
+		default:
+			return ``, fmt.Errorf("unknown escape \\%c in string %s", s[i], s)
+		}
+	}
+
+	return sb.String(), nil
+}
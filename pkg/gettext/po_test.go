@@ -0,0 +1,129 @@
+package gettext //nolint:testpackage
+
+import (
+	"strings"
+	"testing"
+)
+
+const testPO = `# Polish translation.
+msgid ""
+msgstr ""
+"Language: pl\n"
+"Content-Type: text/plain; charset=UTF-8\n"
+"Plural-Forms: nplurals=3; plural=(n==1 ? 0 : n%10>=2 && n%10<=4 && "
+"(n%100<10 || n%100>=20) ? 1 : 2);\n"
+
+#: main.go:12
+msgid "file"
+msgstr "plik"
+
+#, c-format
+msgid "%d file"
+msgid_plural "%d files"
+msgstr[0] "%d plik"
+msgstr[1] "%d pliki"
+msgstr[2] "%d plików"
+
+msgctxt "menu"
+msgid "Open"
+msgstr "Otwórz"
+
+msgid "Open"
+msgstr "Otwieranie"
+
+msgid "multi"
+"line \"quoted\"\t"
+msgstr "wiele\n"
+"linii"
+
+msgid "untranslated"
+msgid_plural "untranslateds"
+msgstr[0] ""
+msgstr[1] ""
+msgstr[2] ""
+`
+
+func TestReadPO(t *testing.T) {
+	cat, err := ReadPO(strings.NewReader(testPO))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cat.Len() != 6 {
+		t.Errorf("expected 6 entries, actual %d", cat.Len())
+	}
+
+	if cat.Header["Language"] != `pl` {
+		t.Errorf("expected Language header pl, actual %s", cat.Header["Language"])
+	}
+
+	if cat.PluralForms.NPlurals != 3 {
+		t.Errorf("expected nplurals=3, actual %d", cat.PluralForms.NPlurals)
+	}
+
+	tests := []lookupTest{
+		{cat.Get(`file`), `plik`},
+		{cat.Get(`missing`), `missing`},
+		{cat.Get(`Open`), `Otwieranie`},
+		{cat.PGet(`menu`, `Open`), `Otwórz`},
+		{cat.Get("multiline \"quoted\"\t"), "wiele\nlinii"},
+		{cat.GetN(`%d file`, `%d files`, 1), `%d plik`},
+		{cat.GetN(`%d file`, `%d files`, 3), `%d pliki`},
+		{cat.GetN(`%d file`, `%d files`, 5), `%d plików`},
+		{cat.GetN(`%d file`, `%d files`, 22), `%d pliki`},
+		{cat.GetN(`%d file`, `%d files`, 112), `%d plików`},
+		{cat.GetN(`untranslated`, `untranslateds`, 1), `untranslated`},
+		{cat.GetN(`untranslated`, `untranslateds`, 2), `untranslateds`},
+		{cat.GetN(`missing`, `missings`, 0), `missings`},
+	}
+
+	for i, test := range tests {
+		if test.actual != test.expected {
+			t.Errorf("FAIL test[%d] expected %q, actual %q", i, test.expected, test.actual)
+		}
+	}
+}
+
+func TestNewCatalog(t *testing.T) {
+	cat, err := NewCatalog(``)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cat.Add(&Entry{ID: `day`, IDPlural: `days`, Str: []string{`jour`, `jours`}})
+
+	if cat.GetN(`day`, `days`, 1) != `jour` {
+		t.Fail()
+	}
+
+	if cat.GetN(`day`, `days`, 0) != `jours` {
+		t.Fail()
+	}
+
+	if _, err := NewCatalog(`nplurals=2;`); err == nil {
+		t.Error("expected invalid Plural-Forms error")
+	}
+}
+
+func TestReadPOErrors(t *testing.T) {
+	tests := []string{
+		"msgid \"a\"\n",
+		"msgid \"a\nmsgstr \"b\"\n",
+		"msgid \"a\"\nmsgstr[1] \"b\"\n",
+		"msgid \"a\"\nmsgstr \"\\q\"\n",
+		"msgfoo \"a\"\n",
+		"msgid \"\"\nmsgstr \"Plural-Forms: nplurals=x;\\n\"\n",
+		"msgid \"a\"\nmsgid_plural \"b\"\nmsgstr[0] \"\"\nmsgstr[1] \"\"\nmsgstr[2] \"\"\n",
+	}
+
+	for i, po := range tests {
+		if _, err := ReadPO(strings.NewReader(po)); err == nil {
+			t.Errorf("FAIL test[%d] ReadPO(%q) expected error", i, po)
+		}
+	}
+}
+
+type lookupTest struct {
+	actual   string
+	expected string
+}
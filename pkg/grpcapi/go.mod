@@ -0,0 +1,19 @@
+module github.com/gertd/go-pluralize/pkg/grpcapi
+
+go 1.17
+
+require (
+	github.com/gertd/go-pluralize v0.2.0
+	google.golang.org/grpc v1.56.3
+	google.golang.org/protobuf v1.30.0
+)
+
+require (
+	github.com/golang/protobuf v1.5.3 // indirect
+	golang.org/x/net v0.17.0 // indirect
+	golang.org/x/sys v0.13.0 // indirect
+	golang.org/x/text v0.13.0 // indirect
+	google.golang.org/genproto v0.0.0-20230410155749-daa745c078e1 // indirect
+)
+
+replace github.com/gertd/go-pluralize => ../..
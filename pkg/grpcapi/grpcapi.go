@@ -0,0 +1,144 @@
+// Package grpcapi -- gRPC service for a pluralize client, see pluralizepb/pluralize.proto for the API.
+//
+//	s := grpc.NewServer()
+//	pluralizepb.RegisterPluralizeServer(s, grpcapi.NewServer(pluralize.NewClient()))
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gertd/go-pluralize"
+	"github.com/gertd/go-pluralize/pkg/grpcapi/pluralizepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultMaxWordLen -- default maximum word length in bytes.
+const DefaultMaxWordLen = 1024
+
+// Server -- implements pluralizepb.PluralizeServer with a shared client.
+// The client must not be modified while the server is in use.
+type Server struct {
+	pluralizepb.UnimplementedPluralizeServer
+	MaxWordLen int
+	client     *pluralize.Client
+}
+
+// NewServer -- server factory method.
+func NewServer(client *pluralize.Client) *Server {
+	return &Server{
+		MaxWordLen: DefaultMaxWordLen,
+		client:     client,
+	}
+}
+
+// Plural -- implements pluralizepb.PluralizeServer.
+func (s *Server) Plural(ctx context.Context, req *pluralizepb.WordRequest) (*pluralizepb.WordResponse, error) {
+	if err := s.validate(req.GetWord()); err != nil {
+		return nil, err
+	}
+
+	return &pluralizepb.WordResponse{Input: req.GetWord(), Result: s.client.Plural(req.GetWord())}, nil
+}
+
+// Singular -- implements pluralizepb.PluralizeServer.
+func (s *Server) Singular(ctx context.Context, req *pluralizepb.WordRequest) (*pluralizepb.WordResponse, error) {
+	if err := s.validate(req.GetWord()); err != nil {
+		return nil, err
+	}
+
+	return &pluralizepb.WordResponse{Input: req.GetWord(), Result: s.client.Singular(req.GetWord())}, nil
+}
+
+// IsPlural -- implements pluralizepb.PluralizeServer.
+func (s *Server) IsPlural(ctx context.Context, req *pluralizepb.WordRequest) (*pluralizepb.BoolResponse, error) {
+	if err := s.validate(req.GetWord()); err != nil {
+		return nil, err
+	}
+
+	return &pluralizepb.BoolResponse{Input: req.GetWord(), Result: s.client.IsPlural(req.GetWord())}, nil
+}
+
+// IsSingular -- implements pluralizepb.PluralizeServer.
+func (s *Server) IsSingular(ctx context.Context, req *pluralizepb.WordRequest) (*pluralizepb.BoolResponse, error) {
+	if err := s.validate(req.GetWord()); err != nil {
+		return nil, err
+	}
+
+	return &pluralizepb.BoolResponse{Input: req.GetWord(), Result: s.client.IsSingular(req.GetWord())}, nil
+}
+
+// Pluralize -- implements pluralizepb.PluralizeServer.
+func (s *Server) Pluralize(ctx context.Context, req *pluralizepb.PluralizeRequest) (*pluralizepb.WordResponse, error) {
+	if err := s.validate(req.GetWord()); err != nil {
+		return nil, err
+	}
+
+	return &pluralizepb.WordResponse{
+		Input:  req.GetWord(),
+		Result: s.client.Pluralize(req.GetWord(), int(req.GetCount()), req.GetInclusive()),
+	}, nil
+}
+
+// Batch -- implements pluralizepb.PluralizeServer, responses are sent in request order.
+func (s *Server) Batch(stream pluralizepb.Pluralize_BatchServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		resp, err := s.batch(req)
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) batch(req *pluralizepb.BatchRequest) (*pluralizepb.BatchResponse, error) {
+	word := req.GetWord()
+
+	if err := s.validate(word); err != nil {
+		return nil, err
+	}
+
+	resp := pluralizepb.BatchResponse{Operation: req.GetOperation(), Input: word}
+
+	switch req.GetOperation() {
+	case pluralizepb.Operation_OPERATION_PLURAL:
+		resp.Result = s.client.Plural(word)
+	case pluralizepb.Operation_OPERATION_SINGULAR:
+		resp.Result = s.client.Singular(word)
+	case pluralizepb.Operation_OPERATION_IS_PLURAL:
+		resp.Match = s.client.IsPlural(word)
+	case pluralizepb.Operation_OPERATION_IS_SINGULAR:
+		resp.Match = s.client.IsSingular(word)
+	case pluralizepb.Operation_OPERATION_PLURALIZE:
+		resp.Result = s.client.Pluralize(word, int(req.GetCount()), req.GetInclusive())
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown operation %s", req.GetOperation())
+	}
+
+	return &resp, nil
+}
+
+func (s *Server) validate(word string) error {
+	switch {
+	case len(word) == 0:
+		return status.Error(codes.InvalidArgument, "word not specified")
+	case len(word) > s.MaxWordLen:
+		return status.Errorf(codes.InvalidArgument, "word exceeds %d bytes", s.MaxWordLen)
+	default:
+		return nil
+	}
+}
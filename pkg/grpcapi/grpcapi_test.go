@@ -0,0 +1,178 @@
+package grpcapi //nolint:testpackage
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/gertd/go-pluralize"
+	"github.com/gertd/go-pluralize/pkg/grpcapi/pluralizepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newTestClient -- in-process client connected to the server over bufconn.
+func newTestClient(t *testing.T) pluralizepb.PluralizeClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+
+	s := grpc.NewServer()
+	pluralizepb.RegisterPluralizeServer(s, NewServer(pluralize.NewClient()))
+
+	go func() {
+		_ = s.Serve(lis)
+	}()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("FAIL dial %v", err)
+	}
+
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+
+	return pluralizepb.NewPluralizeClient(conn)
+}
+
+func TestServer(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		call     func() (interface{}, error)
+		expected string
+	}{
+		{func() (interface{}, error) { return c.Plural(ctx, &pluralizepb.WordRequest{Word: "box"}) }, `boxes`},
+		{func() (interface{}, error) { return c.Singular(ctx, &pluralizepb.WordRequest{Word: "Cacti"}) }, `Cactus`},
+		{func() (interface{}, error) { return c.IsPlural(ctx, &pluralizepb.WordRequest{Word: "geese"}) }, `true`},
+		{func() (interface{}, error) { return c.IsSingular(ctx, &pluralizepb.WordRequest{Word: "geese"}) }, `false`},
+		{func() (interface{}, error) {
+			return c.Pluralize(ctx, &pluralizepb.PluralizeRequest{Word: "duck", Count: 3, Inclusive: true})
+		}, `3 ducks`},
+	}
+
+	for i, test := range tests {
+		resp, err := test.call()
+		if err != nil {
+			t.Errorf("FAIL test[%d] error %v", i, err)
+			continue
+		}
+
+		var actual string
+
+		switch r := resp.(type) {
+		case *pluralizepb.WordResponse:
+			actual = r.GetResult()
+		case *pluralizepb.BoolResponse:
+			if r.GetResult() {
+				actual = `true`
+			} else {
+				actual = `false`
+			}
+		}
+
+		if actual != test.expected {
+			t.Errorf("FAIL test[%d] expected %s, actual %s", i, test.expected, actual)
+		}
+	}
+}
+
+func TestServerInvalid(t *testing.T) {
+	c := newTestClient(t)
+	ctx := context.Background()
+
+	for i, word := range []string{``, string(make([]byte, DefaultMaxWordLen+1))} {
+		_, err := c.Plural(ctx, &pluralizepb.WordRequest{Word: word})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("FAIL test[%d] expected %s, actual %v", i, codes.InvalidArgument, err)
+		}
+	}
+}
+
+func TestBatch(t *testing.T) {
+	c := newTestClient(t)
+
+	stream, err := c.Batch(context.Background())
+	if err != nil {
+		t.Fatalf("FAIL Batch %v", err)
+	}
+
+	requests := []*pluralizepb.BatchRequest{
+		{Operation: pluralizepb.Operation_OPERATION_PLURAL, Word: "mouse"},
+		{Operation: pluralizepb.Operation_OPERATION_SINGULAR, Word: "people"},
+		{Operation: pluralizepb.Operation_OPERATION_IS_PLURAL, Word: "boxes"},
+		{Operation: pluralizepb.Operation_OPERATION_IS_SINGULAR, Word: "boxes"},
+		{Operation: pluralizepb.Operation_OPERATION_PLURALIZE, Word: "child", Count: 2, Inclusive: true},
+	}
+
+	expected := []struct {
+		result string
+		match  bool
+	}{
+		{`mice`, false},
+		{`person`, false},
+		{``, true},
+		{``, false},
+		{`2 children`, false},
+	}
+
+	for _, req := range requests {
+		if err := stream.Send(req); err != nil {
+			t.Fatalf("FAIL Send %v", err)
+		}
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("FAIL CloseSend %v", err)
+	}
+
+	for i := 0; ; i++ {
+		resp, err := stream.Recv()
+		if err == io.EOF { //nolint:errorlint
+			if i != len(expected) {
+				t.Errorf("FAIL expected %d responses, actual %d", len(expected), i)
+			}
+
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("FAIL Recv %v", err)
+		}
+
+		if i >= len(expected) || resp.GetOperation() != requests[i].GetOperation() ||
+			resp.GetInput() != requests[i].GetWord() ||
+			resp.GetResult() != expected[i].result || resp.GetMatch() != expected[i].match {
+			t.Errorf("FAIL response[%d] %v", i, resp)
+		}
+	}
+}
+
+func TestBatchInvalid(t *testing.T) {
+	c := newTestClient(t)
+
+	stream, err := c.Batch(context.Background())
+	if err != nil {
+		t.Fatalf("FAIL Batch %v", err)
+	}
+
+	if err := stream.Send(&pluralizepb.BatchRequest{Word: "box"}); err != nil {
+		t.Fatalf("FAIL Send %v", err)
+	}
+
+	if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("FAIL expected %s, actual %v", codes.InvalidArgument, err)
+	}
+}
@@ -0,0 +1,4 @@
+// Package pluralizepb -- generated protobuf and gRPC stubs of the pluralize.v1 API.
+package pluralizepb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative pluralize.proto
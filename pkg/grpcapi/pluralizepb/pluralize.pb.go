@@ -0,0 +1,672 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: pluralize.proto
+
+package pluralizepb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Operation int32
+
+const (
+	Operation_OPERATION_UNSPECIFIED Operation = 0
+	Operation_OPERATION_PLURAL      Operation = 1
+	Operation_OPERATION_SINGULAR    Operation = 2
+	Operation_OPERATION_IS_PLURAL   Operation = 3
+	Operation_OPERATION_IS_SINGULAR Operation = 4
+	Operation_OPERATION_PLURALIZE   Operation = 5
+)
+
+// Enum value maps for Operation.
+var (
+	Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_PLURAL",
+		2: "OPERATION_SINGULAR",
+		3: "OPERATION_IS_PLURAL",
+		4: "OPERATION_IS_SINGULAR",
+		5: "OPERATION_PLURALIZE",
+	}
+	Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_PLURAL":      1,
+		"OPERATION_SINGULAR":    2,
+		"OPERATION_IS_PLURAL":   3,
+		"OPERATION_IS_SINGULAR": 4,
+		"OPERATION_PLURALIZE":   5,
+	}
+)
+
+func (x Operation) Enum() *Operation {
+	p := new(Operation)
+	*p = x
+	return p
+}
+
+func (x Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_pluralize_proto_enumTypes[0].Descriptor()
+}
+
+func (Operation) Type() protoreflect.EnumType {
+	return &file_pluralize_proto_enumTypes[0]
+}
+
+func (x Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Operation.Descriptor instead.
+func (Operation) EnumDescriptor() ([]byte, []int) {
+	return file_pluralize_proto_rawDescGZIP(), []int{0}
+}
+
+type WordRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+}
+
+func (x *WordRequest) Reset() {
+	*x = WordRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluralize_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordRequest) ProtoMessage() {}
+
+func (x *WordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pluralize_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordRequest.ProtoReflect.Descriptor instead.
+func (*WordRequest) Descriptor() ([]byte, []int) {
+	return file_pluralize_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WordRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+type WordResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Input  string `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Result string `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *WordResponse) Reset() {
+	*x = WordResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluralize_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WordResponse) ProtoMessage() {}
+
+func (x *WordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pluralize_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WordResponse.ProtoReflect.Descriptor instead.
+func (*WordResponse) Descriptor() ([]byte, []int) {
+	return file_pluralize_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WordResponse) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *WordResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+type BoolResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Input  string `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Result bool   `protobuf:"varint,2,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *BoolResponse) Reset() {
+	*x = BoolResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluralize_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoolResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoolResponse) ProtoMessage() {}
+
+func (x *BoolResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pluralize_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoolResponse.ProtoReflect.Descriptor instead.
+func (*BoolResponse) Descriptor() ([]byte, []int) {
+	return file_pluralize_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BoolResponse) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *BoolResponse) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+type PluralizeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Word  string `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Count int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	// inclusive -- prefix the result with the count (e.g. 3 ducks).
+	Inclusive bool `protobuf:"varint,3,opt,name=inclusive,proto3" json:"inclusive,omitempty"`
+}
+
+func (x *PluralizeRequest) Reset() {
+	*x = PluralizeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluralize_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PluralizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluralizeRequest) ProtoMessage() {}
+
+func (x *PluralizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pluralize_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluralizeRequest.ProtoReflect.Descriptor instead.
+func (*PluralizeRequest) Descriptor() ([]byte, []int) {
+	return file_pluralize_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PluralizeRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *PluralizeRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PluralizeRequest) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+type BatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=pluralize.v1.Operation" json:"operation,omitempty"`
+	Word      string    `protobuf:"bytes,2,opt,name=word,proto3" json:"word,omitempty"`
+	// count and inclusive -- only used by OPERATION_PLURALIZE.
+	Count     int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Inclusive bool  `protobuf:"varint,4,opt,name=inclusive,proto3" json:"inclusive,omitempty"`
+}
+
+func (x *BatchRequest) Reset() {
+	*x = BatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluralize_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchRequest) ProtoMessage() {}
+
+func (x *BatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pluralize_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return file_pluralize_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchRequest) GetOperation() Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return Operation_OPERATION_UNSPECIFIED
+}
+
+func (x *BatchRequest) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *BatchRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *BatchRequest) GetInclusive() bool {
+	if x != nil {
+		return x.Inclusive
+	}
+	return false
+}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation Operation `protobuf:"varint,1,opt,name=operation,proto3,enum=pluralize.v1.Operation" json:"operation,omitempty"`
+	Input     string    `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// result -- set by OPERATION_PLURAL, OPERATION_SINGULAR and OPERATION_PLURALIZE.
+	Result string `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+	// match -- set by OPERATION_IS_PLURAL and OPERATION_IS_SINGULAR.
+	Match bool `protobuf:"varint,4,opt,name=match,proto3" json:"match,omitempty"`
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pluralize_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pluralize_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_pluralize_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchResponse) GetOperation() Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return Operation_OPERATION_UNSPECIFIED
+}
+
+func (x *BatchResponse) GetInput() string {
+	if x != nil {
+		return x.Input
+	}
+	return ""
+}
+
+func (x *BatchResponse) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *BatchResponse) GetMatch() bool {
+	if x != nil {
+		return x.Match
+	}
+	return false
+}
+
+var File_pluralize_proto protoreflect.FileDescriptor
+
+var file_pluralize_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31, 0x22,
+	0x21, 0x0a, 0x0b, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x77, 0x6f,
+	0x72, 0x64, 0x22, 0x3c, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x3c, 0x0a, 0x0c, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x5a,
+	0x0a, 0x10, 0x50, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0d, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x09,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x2a, 0xa1, 0x01, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4c,
+	0x55, 0x52, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x55, 0x4c, 0x41, 0x52, 0x10, 0x02, 0x12, 0x17,
+	0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x50,
+	0x4c, 0x55, 0x52, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x4f, 0x50, 0x45, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x53, 0x5f, 0x53, 0x49, 0x4e, 0x47, 0x55, 0x4c, 0x41, 0x52,
+	0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x4c, 0x55, 0x52, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x10, 0x05, 0x32, 0xa6, 0x03, 0x0a, 0x09,
+	0x50, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x50, 0x6c, 0x75,
+	0x72, 0x61, 0x6c, 0x12, 0x19, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x08, 0x53, 0x69,
+	0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x08, 0x49, 0x73, 0x50, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x12, 0x19, 0x2e, 0x70, 0x6c, 0x75, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x0a, 0x49, 0x73, 0x53, 0x69, 0x6e, 0x67, 0x75, 0x6c, 0x61, 0x72, 0x12, 0x19,
+	0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6c, 0x75, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x50, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1a, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x28, 0x01, 0x30, 0x01, 0x42, 0x37, 0x5a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x67, 0x65, 0x72, 0x74, 0x64, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x6c, 0x75, 0x72,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61, 0x70,
+	0x69, 0x2f, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pluralize_proto_rawDescOnce sync.Once
+	file_pluralize_proto_rawDescData = file_pluralize_proto_rawDesc
+)
+
+func file_pluralize_proto_rawDescGZIP() []byte {
+	file_pluralize_proto_rawDescOnce.Do(func() {
+		file_pluralize_proto_rawDescData = protoimpl.X.CompressGZIP(file_pluralize_proto_rawDescData)
+	})
+	return file_pluralize_proto_rawDescData
+}
+
+var file_pluralize_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pluralize_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_pluralize_proto_goTypes = []interface{}{
+	(Operation)(0),           // 0: pluralize.v1.Operation
+	(*WordRequest)(nil),      // 1: pluralize.v1.WordRequest
+	(*WordResponse)(nil),     // 2: pluralize.v1.WordResponse
+	(*BoolResponse)(nil),     // 3: pluralize.v1.BoolResponse
+	(*PluralizeRequest)(nil), // 4: pluralize.v1.PluralizeRequest
+	(*BatchRequest)(nil),     // 5: pluralize.v1.BatchRequest
+	(*BatchResponse)(nil),    // 6: pluralize.v1.BatchResponse
+}
+var file_pluralize_proto_depIdxs = []int32{
+	0, // 0: pluralize.v1.BatchRequest.operation:type_name -> pluralize.v1.Operation
+	0, // 1: pluralize.v1.BatchResponse.operation:type_name -> pluralize.v1.Operation
+	1, // 2: pluralize.v1.Pluralize.Plural:input_type -> pluralize.v1.WordRequest
+	1, // 3: pluralize.v1.Pluralize.Singular:input_type -> pluralize.v1.WordRequest
+	1, // 4: pluralize.v1.Pluralize.IsPlural:input_type -> pluralize.v1.WordRequest
+	1, // 5: pluralize.v1.Pluralize.IsSingular:input_type -> pluralize.v1.WordRequest
+	4, // 6: pluralize.v1.Pluralize.Pluralize:input_type -> pluralize.v1.PluralizeRequest
+	5, // 7: pluralize.v1.Pluralize.Batch:input_type -> pluralize.v1.BatchRequest
+	2, // 8: pluralize.v1.Pluralize.Plural:output_type -> pluralize.v1.WordResponse
+	2, // 9: pluralize.v1.Pluralize.Singular:output_type -> pluralize.v1.WordResponse
+	3, // 10: pluralize.v1.Pluralize.IsPlural:output_type -> pluralize.v1.BoolResponse
+	3, // 11: pluralize.v1.Pluralize.IsSingular:output_type -> pluralize.v1.BoolResponse
+	2, // 12: pluralize.v1.Pluralize.Pluralize:output_type -> pluralize.v1.WordResponse
+	6, // 13: pluralize.v1.Pluralize.Batch:output_type -> pluralize.v1.BatchResponse
+	8, // [8:14] is the sub-list for method output_type
+	2, // [2:8] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_pluralize_proto_init() }
+func file_pluralize_proto_init() {
+	if File_pluralize_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pluralize_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluralize_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WordResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluralize_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoolResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluralize_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PluralizeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluralize_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pluralize_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pluralize_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pluralize_proto_goTypes,
+		DependencyIndexes: file_pluralize_proto_depIdxs,
+		EnumInfos:         file_pluralize_proto_enumTypes,
+		MessageInfos:      file_pluralize_proto_msgTypes,
+	}.Build()
+	File_pluralize_proto = out.File
+	file_pluralize_proto_rawDesc = nil
+	file_pluralize_proto_goTypes = nil
+	file_pluralize_proto_depIdxs = nil
+}
@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: pluralize.proto
+
+package pluralizepb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Pluralize_Plural_FullMethodName     = "/pluralize.v1.Pluralize/Plural"
+	Pluralize_Singular_FullMethodName   = "/pluralize.v1.Pluralize/Singular"
+	Pluralize_IsPlural_FullMethodName   = "/pluralize.v1.Pluralize/IsPlural"
+	Pluralize_IsSingular_FullMethodName = "/pluralize.v1.Pluralize/IsSingular"
+	Pluralize_Pluralize_FullMethodName  = "/pluralize.v1.Pluralize/Pluralize"
+	Pluralize_Batch_FullMethodName      = "/pluralize.v1.Pluralize/Batch"
+)
+
+// PluralizeClient is the client API for Pluralize service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PluralizeClient interface {
+	// Plural -- plural form of a word.
+	Plural(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*WordResponse, error)
+	// Singular -- singular form of a word.
+	Singular(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*WordResponse, error)
+	// IsPlural -- whether a word is plural.
+	IsPlural(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	// IsSingular -- whether a word is singular.
+	IsSingular(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*BoolResponse, error)
+	// Pluralize -- word inflected by count.
+	Pluralize(ctx context.Context, in *PluralizeRequest, opts ...grpc.CallOption) (*WordResponse, error)
+	// Batch -- one response per request, in request order.
+	Batch(ctx context.Context, opts ...grpc.CallOption) (Pluralize_BatchClient, error)
+}
+
+type pluralizeClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPluralizeClient(cc grpc.ClientConnInterface) PluralizeClient {
+	return &pluralizeClient{cc}
+}
+
+func (c *pluralizeClient) Plural(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*WordResponse, error) {
+	out := new(WordResponse)
+	err := c.cc.Invoke(ctx, Pluralize_Plural_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluralizeClient) Singular(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*WordResponse, error) {
+	out := new(WordResponse)
+	err := c.cc.Invoke(ctx, Pluralize_Singular_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluralizeClient) IsPlural(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Pluralize_IsPlural_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluralizeClient) IsSingular(ctx context.Context, in *WordRequest, opts ...grpc.CallOption) (*BoolResponse, error) {
+	out := new(BoolResponse)
+	err := c.cc.Invoke(ctx, Pluralize_IsSingular_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluralizeClient) Pluralize(ctx context.Context, in *PluralizeRequest, opts ...grpc.CallOption) (*WordResponse, error) {
+	out := new(WordResponse)
+	err := c.cc.Invoke(ctx, Pluralize_Pluralize_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pluralizeClient) Batch(ctx context.Context, opts ...grpc.CallOption) (Pluralize_BatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Pluralize_ServiceDesc.Streams[0], Pluralize_Batch_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pluralizeBatchClient{stream}
+	return x, nil
+}
+
+type Pluralize_BatchClient interface {
+	Send(*BatchRequest) error
+	Recv() (*BatchResponse, error)
+	grpc.ClientStream
+}
+
+type pluralizeBatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *pluralizeBatchClient) Send(m *BatchRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *pluralizeBatchClient) Recv() (*BatchResponse, error) {
+	m := new(BatchResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PluralizeServer is the server API for Pluralize service.
+// All implementations must embed UnimplementedPluralizeServer
+// for forward compatibility
+type PluralizeServer interface {
+	// Plural -- plural form of a word.
+	Plural(context.Context, *WordRequest) (*WordResponse, error)
+	// Singular -- singular form of a word.
+	Singular(context.Context, *WordRequest) (*WordResponse, error)
+	// IsPlural -- whether a word is plural.
+	IsPlural(context.Context, *WordRequest) (*BoolResponse, error)
+	// IsSingular -- whether a word is singular.
+	IsSingular(context.Context, *WordRequest) (*BoolResponse, error)
+	// Pluralize -- word inflected by count.
+	Pluralize(context.Context, *PluralizeRequest) (*WordResponse, error)
+	// Batch -- one response per request, in request order.
+	Batch(Pluralize_BatchServer) error
+	mustEmbedUnimplementedPluralizeServer()
+}
+
+// UnimplementedPluralizeServer must be embedded to have forward compatible implementations.
+type UnimplementedPluralizeServer struct {
+}
+
+func (UnimplementedPluralizeServer) Plural(context.Context, *WordRequest) (*WordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Plural not implemented")
+}
+func (UnimplementedPluralizeServer) Singular(context.Context, *WordRequest) (*WordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Singular not implemented")
+}
+func (UnimplementedPluralizeServer) IsPlural(context.Context, *WordRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPlural not implemented")
+}
+func (UnimplementedPluralizeServer) IsSingular(context.Context, *WordRequest) (*BoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsSingular not implemented")
+}
+func (UnimplementedPluralizeServer) Pluralize(context.Context, *PluralizeRequest) (*WordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pluralize not implemented")
+}
+func (UnimplementedPluralizeServer) Batch(Pluralize_BatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedPluralizeServer) mustEmbedUnimplementedPluralizeServer() {}
+
+// UnsafePluralizeServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PluralizeServer will
+// result in compilation errors.
+type UnsafePluralizeServer interface {
+	mustEmbedUnimplementedPluralizeServer()
+}
+
+func RegisterPluralizeServer(s grpc.ServiceRegistrar, srv PluralizeServer) {
+	s.RegisterService(&Pluralize_ServiceDesc, srv)
+}
+
+func _Pluralize_Plural_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluralizeServer).Plural(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pluralize_Plural_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluralizeServer).Plural(ctx, req.(*WordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pluralize_Singular_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluralizeServer).Singular(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pluralize_Singular_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluralizeServer).Singular(ctx, req.(*WordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pluralize_IsPlural_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluralizeServer).IsPlural(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pluralize_IsPlural_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluralizeServer).IsPlural(ctx, req.(*WordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pluralize_IsSingular_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluralizeServer).IsSingular(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pluralize_IsSingular_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluralizeServer).IsSingular(ctx, req.(*WordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pluralize_Pluralize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PluralizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PluralizeServer).Pluralize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Pluralize_Pluralize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PluralizeServer).Pluralize(ctx, req.(*PluralizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Pluralize_Batch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PluralizeServer).Batch(&pluralizeBatchServer{stream})
+}
+
+type Pluralize_BatchServer interface {
+	Send(*BatchResponse) error
+	Recv() (*BatchRequest, error)
+	grpc.ServerStream
+}
+
+type pluralizeBatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *pluralizeBatchServer) Send(m *BatchResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *pluralizeBatchServer) Recv() (*BatchRequest, error) {
+	m := new(BatchRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Pluralize_ServiceDesc is the grpc.ServiceDesc for Pluralize service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Pluralize_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pluralize.v1.Pluralize",
+	HandlerType: (*PluralizeServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Plural",
+			Handler:    _Pluralize_Plural_Handler,
+		},
+		{
+			MethodName: "Singular",
+			Handler:    _Pluralize_Singular_Handler,
+		},
+		{
+			MethodName: "IsPlural",
+			Handler:    _Pluralize_IsPlural_Handler,
+		},
+		{
+			MethodName: "IsSingular",
+			Handler:    _Pluralize_IsSingular_Handler,
+		},
+		{
+			MethodName: "Pluralize",
+			Handler:    _Pluralize_Pluralize_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Batch",
+			Handler:       _Pluralize_Batch_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pluralize.proto",
+}
@@ -0,0 +1,233 @@
+// Package httpapi -- HTTP JSON service for a pluralize client.
+//
+// All endpoints accept POST requests with a JSON body and respond with JSON, errors are
+// returned as {"error": "..."} with a 4xx status code.
+//
+//	POST /v1/plural           {"word": "box"}                => {"input": "box", "result": "boxes"}
+//	POST /v1/singular         {"word": "boxes"}              => {"input": "boxes", "result": "box"}
+//	POST /v1/classify         {"word": "boxes"}              => {"input": "boxes", "isPlural": true, "isSingular": false}
+//	POST /v1/pluralize        {"word": "duck", "count": 3, "inclusive": true} => {"input": "duck", "result": "3 ducks"}
+//	GET  /healthz
+//
+// Each endpoint has a batch variant (e.g. /v1/plural/batch) taking {"items": [request, ...]}
+// and responding with {"items": [response, ...]} in request order. A batch with an invalid
+// item is rejected as a whole, the error names the item (e.g. items[1]: word not specified).
+package httpapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
+const (
+	// DefaultMaxBodyBytes -- default maximum request body size.
+	DefaultMaxBodyBytes = 1 << 20
+	// DefaultMaxBatchSize -- default maximum number of items in a batch request.
+	DefaultMaxBatchSize = 1000
+)
+
+// Request -- single word request, Count and Inclusive are only used by /v1/pluralize.
+type Request struct {
+	Word      string `json:"word"`
+	Count     int    `json:"count"`
+	Inclusive bool   `json:"inclusive"`
+}
+
+// Response -- single word response, Result is set by plural, singular and pluralize,
+// IsPlural and IsSingular by classify.
+type Response struct {
+	Input      string `json:"input"`
+	Result     string `json:"result,omitempty"`
+	IsPlural   *bool  `json:"isPlural,omitempty"`
+	IsSingular *bool  `json:"isSingular,omitempty"`
+}
+
+// BatchRequest -- batch request.
+type BatchRequest struct {
+	Items []Request `json:"items"`
+}
+
+// BatchResponse -- batch response, one item per request item.
+type BatchResponse struct {
+	Items []Response `json:"items"`
+}
+
+// ErrorResponse -- error response.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server -- HTTP handler serving the pluralize endpoints with a shared client.
+// The client must not be modified while the server is in use.
+type Server struct {
+	MaxBodyBytes int64
+	MaxBatchSize int
+	client       *pluralize.Client
+	mux          *http.ServeMux
+}
+
+// NewServer -- server factory method.
+func NewServer(client *pluralize.Client) *Server {
+	s := Server{
+		MaxBodyBytes: DefaultMaxBodyBytes,
+		MaxBatchSize: DefaultMaxBatchSize,
+		client:       client,
+		mux:          http.NewServeMux(),
+	}
+
+	endpoints := map[string]func(Request) Response{
+		"/v1/plural":    s.plural,
+		"/v1/singular":  s.singular,
+		"/v1/classify":  s.classify,
+		"/v1/pluralize": s.pluralize,
+	}
+
+	for path, fn := range endpoints {
+		s.mux.Handle(path, s.single(fn))
+		s.mux.Handle(path+"/batch", s.batch(fn))
+	}
+
+	s.mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+
+	return &s
+}
+
+// ServeHTTP -- implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) plural(req Request) Response {
+	return Response{Input: req.Word, Result: s.client.Plural(req.Word)}
+}
+
+func (s *Server) singular(req Request) Response {
+	return Response{Input: req.Word, Result: s.client.Singular(req.Word)}
+}
+
+func (s *Server) classify(req Request) Response {
+	isPlural, isSingular := s.client.IsPlural(req.Word), s.client.IsSingular(req.Word)
+	return Response{Input: req.Word, IsPlural: &isPlural, IsSingular: &isSingular}
+}
+
+func (s *Server) pluralize(req Request) Response {
+	return Response{Input: req.Word, Result: s.client.Pluralize(req.Word, req.Count, req.Inclusive)}
+}
+
+func (s *Server) single(fn func(Request) Response) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		if !s.decode(w, r, &req) {
+			return
+		}
+
+		if err := validate(req); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		writeJSON(w, http.StatusOK, fn(req))
+	}
+}
+
+func (s *Server) batch(fn func(Request) Response) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req BatchRequest
+		if !s.decode(w, r, &req) {
+			return
+		}
+
+		if len(req.Items) > s.MaxBatchSize {
+			writeError(w, http.StatusRequestEntityTooLarge,
+				fmt.Sprintf("batch of %d items exceeds %d items", len(req.Items), s.MaxBatchSize))
+
+			return
+		}
+
+		for i, item := range req.Items {
+			if err := validate(item); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("items[%d]: %v", i, err))
+				return
+			}
+		}
+
+		resp := BatchResponse{Items: make([]Response, len(req.Items))}
+		for i, item := range req.Items {
+			resp.Items[i] = fn(item)
+		}
+
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+// validate -- validate a single word request, batch requests are rejected when any item is invalid.
+func validate(req Request) error {
+	if len(req.Word) == 0 {
+		return errors.New("word not specified")
+	}
+
+	return nil
+}
+
+// decode -- decode the JSON request body into v, on error the error response is written and false returned.
+func (s *Server) decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("method %s not allowed", r.Method))
+
+		return false
+	}
+
+	if ct := r.Header.Get("Content-Type"); len(ct) > 0 && !strings.HasPrefix(ct, "application/json") {
+		writeError(w, http.StatusUnsupportedMediaType, fmt.Sprintf("content type %s not supported", ct))
+		return false
+	}
+
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.MaxBodyBytes))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(v)
+	if err == nil && dec.More() {
+		err = errors.New("unexpected data after JSON value")
+	}
+
+	switch {
+	case err == nil:
+		return true
+	case isMaxBytesError(err):
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("request body exceeds %d bytes", s.MaxBodyBytes))
+	case errors.Is(err, io.EOF):
+		writeError(w, http.StatusBadRequest, "request body is empty")
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+	}
+
+	return false
+}
+
+// isMaxBytesError -- the error is returned by http.MaxBytesReader on exceeding the limit.
+// http.MaxBytesError requires go1.19, the error is matched by its message.
+func isMaxBytesError(err error) bool {
+	return strings.Contains(err.Error(), "http: request body too large")
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, ErrorResponse{Error: msg})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(v)
+}
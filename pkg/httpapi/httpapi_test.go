@@ -0,0 +1,128 @@
+package httpapi //nolint:testpackage
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gertd/go-pluralize"
+)
+
+func TestServer(t *testing.T) {
+	ts := httptest.NewServer(NewServer(pluralize.NewClient()))
+	defer ts.Close()
+
+	tests := []struct {
+		path     string
+		body     string
+		status   int
+		expected string
+	}{
+		{`/v1/plural`, `{"word":"box"}`, http.StatusOK, `{"input":"box","result":"boxes"}`},
+		{`/v1/singular`, `{"word":"Cacti"}`, http.StatusOK, `{"input":"Cacti","result":"Cactus"}`},
+		{`/v1/classify`, `{"word":"geese"}`, http.StatusOK, `{"input":"geese","isPlural":true,"isSingular":false}`},
+		{`/v1/pluralize`, `{"word":"duck","count":3,"inclusive":true}`, http.StatusOK, `{"input":"duck","result":"3 ducks"}`},
+		{`/v1/pluralize`, `{"word":"duck","count":1}`, http.StatusOK, `{"input":"duck","result":"duck"}`},
+		{
+			`/v1/plural/batch`, `{"items":[{"word":"mouse"},{"word":"sheep"}]}`, http.StatusOK,
+			`{"items":[{"input":"mouse","result":"mice"},{"input":"sheep","result":"sheep"}]}`,
+		},
+		{
+			`/v1/classify/batch`, `{"items":[{"word":"box"}]}`, http.StatusOK,
+			`{"items":[{"input":"box","isPlural":false,"isSingular":true}]}`,
+		},
+		{`/v1/singular/batch`, `{"items":[]}`, http.StatusOK, `{"items":[]}`},
+		{`/v1/plural`, `{"word":""}`, http.StatusBadRequest, `{"error":"word not specified"}`},
+		{`/v1/plural/batch`, `{"items":[{"word":"box"},{"word":""}]}`, http.StatusBadRequest, `{"error":"items[1]: word not specified"}`}, //nolint:lll
+		{`/v1/pluralize/batch`, `{"items":[{"count":2}]}`, http.StatusBadRequest, `{"error":"items[0]: word not specified"}`},
+		{`/v1/plural`, ``, http.StatusBadRequest, `{"error":"request body is empty"}`},
+		{`/v1/plural`, `{"word":"a"} {}`, http.StatusBadRequest, `{"error":"invalid request body: unexpected data after JSON value"}`}, //nolint:lll
+		{`/v1/plural`, `{"words":"a"}`, http.StatusBadRequest, `{"error":"invalid request body: json: unknown field \"words\""}`},      //nolint:lll
+		{`/v1/unknown`, `{}`, http.StatusNotFound, `404 page not found`},
+	}
+
+	for i, test := range tests {
+		resp, err := http.Post(ts.URL+test.path, "application/json", strings.NewReader(test.body)) //nolint:noctx
+		if err != nil {
+			t.Fatalf("FAIL test[%d] %s error %v", i, test.path, err)
+		}
+
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if actual := strings.TrimSpace(string(body)); resp.StatusCode != test.status || actual != test.expected {
+			t.Errorf("FAIL test[%d] %s expected %d %s, actual %d %s", i, test.path, test.status, test.expected,
+				resp.StatusCode, actual)
+		}
+	}
+}
+
+func TestServerLimits(t *testing.T) {
+	s := NewServer(pluralize.NewClient())
+	s.MaxBodyBytes = 64
+	s.MaxBatchSize = 2
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		ctype  string
+		status int
+	}{
+		{http.MethodPost, `/v1/plural`, `{"word":"` + strings.Repeat("a", 64) + `"}`, "application/json", http.StatusRequestEntityTooLarge}, //nolint:lll
+		{http.MethodPost, `/v1/plural/batch`, `{"items":[{"word":"a"},{"word":"b"},{"word":"c"}]}`, "", http.StatusRequestEntityTooLarge},   //nolint:lll
+		{http.MethodPost, `/v1/plural/batch`, `{"items":[{"word":"a"},{"word":"b"}]}`, "", http.StatusOK},
+		{http.MethodPost, `/v1/plural`, `word=a`, "application/x-www-form-urlencoded", http.StatusUnsupportedMediaType},
+		{http.MethodGet, `/v1/plural`, ``, "", http.StatusMethodNotAllowed},
+		{http.MethodGet, `/healthz`, ``, "", http.StatusOK},
+	}
+
+	for i, test := range tests {
+		req := httptest.NewRequest(test.method, test.path, strings.NewReader(test.body))
+		if len(test.ctype) > 0 {
+			req.Header.Set("Content-Type", test.ctype)
+		}
+
+		rec := httptest.NewRecorder()
+		s.ServeHTTP(rec, req)
+
+		if rec.Code != test.status {
+			t.Errorf("FAIL test[%d] %s %s expected %d, actual %d %s", i, test.method, test.path, test.status,
+				rec.Code, rec.Body.String())
+		}
+
+		if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+			t.Errorf("FAIL test[%d] %s %s expected application/json, actual %s", i, test.method, test.path, ct)
+		}
+	}
+}
+
+// TestServerConcurrent -- the shared client serves concurrent requests, run with -race.
+func TestServerConcurrent(t *testing.T) {
+	s := NewServer(pluralize.NewClient())
+
+	done := make(chan int)
+
+	for g := 0; g < 8; g++ {
+		go func() {
+			for i := 0; i < 50; i++ {
+				req := httptest.NewRequest(http.MethodPost, `/v1/plural/batch`,
+					strings.NewReader(`{"items":[{"word":"person"},{"word":"cactus"}]}`))
+				rec := httptest.NewRecorder()
+				s.ServeHTTP(rec, req)
+
+				if rec.Code != http.StatusOK {
+					t.Errorf("FAIL status %d %s", rec.Code, rec.Body.String())
+				}
+			}
+
+			done <- 1
+		}()
+	}
+
+	for g := 0; g < 8; g++ {
+		<-done
+	}
+}
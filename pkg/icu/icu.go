@@ -0,0 +1,566 @@
+// Package icu -- ICU MessageFormat plural and selectordinal support.
+//
+// Supported argument forms:
+//
+//	{name}
+//	{name, number}
+//	{name, select, male {...} female {...} other {...}}
+//	{name, plural, offset:1 =0 {...} one {...} other {...}}
+//	{name, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}
+//
+// Within plural and selectordinal messages # is replaced by the count minus the offset.
+// Plural categories are selected using the English rules, when the other message of a
+// plural argument is omitted it is derived from the one message using Client.Plural.
+//
+//	m := icu.MustParse("{n, plural, =0 {no files} one {# file}}")
+//	s, _ := m.Format(pluralize.NewClient(), message.Args{"n": 3}) // 3 files
+package icu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gertd/go-pluralize"
+	"github.com/gertd/go-pluralize/pkg/message"
+)
+
+// Plural category names.
+const (
+	Zero  = "zero"
+	One   = "one"
+	Two   = "two"
+	Few   = "few"
+	Many  = "many"
+	Other = "other"
+)
+
+// Argument type names.
+const (
+	typePlural        = "plural"
+	typeSelectOrdinal = "selectordinal"
+	typeSelect        = "select"
+)
+
+// Message -- compiled ICU message.
+type Message struct {
+	src   string
+	nodes []node
+}
+
+// node -- message element, one of text, argument, hash (#) or plural/select argument.
+type node struct {
+	kind   nodeKind
+	text   string
+	name   string
+	offset int
+	keys   []string
+	cases  map[string][]node
+}
+
+type nodeKind uint8
+
+const (
+	nodeText nodeKind = iota
+	nodeArg
+	nodeHash
+	nodePlural
+	nodeSelectOrdinal
+	nodeSelect
+)
+
+// Parse -- compile an ICU message.
+func Parse(src string) (*Message, error) {
+	p := parser{src: src}
+
+	nodes, err := p.parseMessage(false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Message{src: src, nodes: nodes}, nil
+}
+
+// MustParse -- compile an ICU message, panics on error.
+func MustParse(src string) *Message {
+	m, err := Parse(src)
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// String -- return the message source.
+func (m *Message) String() string {
+	return m.src
+}
+
+// Format -- return the message output for args, c is used to derive omitted plural other messages.
+func (m *Message) Format(c *pluralize.Client, args message.Args) (string, error) {
+	var sb strings.Builder
+
+	if err := format(&sb, m.nodes, c, args, nil); err != nil {
+		return ``, err
+	}
+
+	return sb.String(), nil
+}
+
+// PluralCategory -- English cardinal plural category for n.
+func PluralCategory(n int) string {
+	if n == 1 {
+		return One
+	}
+
+	return Other
+}
+
+// OrdinalCategory -- English ordinal plural category for n (e.g. 1st, 2nd, 3rd, 4th).
+func OrdinalCategory(n int) string {
+	if n < 0 {
+		n = -n
+	}
+
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return One
+	case n%10 == 2 && n%100 != 12:
+		return Two
+	case n%10 == 3 && n%100 != 13:
+		return Few
+	default:
+		return Other
+	}
+}
+
+func format(sb *strings.Builder, nodes []node, c *pluralize.Client, args message.Args, hash *int) error {
+	for i := range nodes {
+		n := &nodes[i]
+
+		switch n.kind {
+		case nodeText:
+			sb.WriteString(n.text)
+		case nodeHash:
+			if hash != nil {
+				sb.WriteString(strconv.Itoa(*hash))
+			} else {
+				sb.WriteByte('#')
+			}
+		case nodeArg:
+			value, ok := args[n.name]
+			if !ok {
+				return fmt.Errorf("icu: missing argument %q", n.name)
+			}
+
+			fmt.Fprint(sb, value)
+		case nodeSelect:
+			value, ok := args[n.name]
+			if !ok {
+				return fmt.Errorf("icu: missing argument %q", n.name)
+			}
+
+			sub, ok := n.cases[fmt.Sprint(value)]
+			if !ok {
+				sub = n.cases[Other]
+			}
+
+			if err := format(sb, sub, c, args, hash); err != nil {
+				return err
+			}
+		case nodePlural, nodeSelectOrdinal:
+			if err := formatPlural(sb, n, c, args); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func formatPlural(sb *strings.Builder, n *node, c *pluralize.Client, args message.Args) error {
+	value, ok := args[n.name]
+	if !ok {
+		return fmt.Errorf("icu: missing argument %q", n.name)
+	}
+
+	count, err := message.Count(value)
+	if err != nil {
+		return fmt.Errorf("icu: argument %q: %w", n.name, err)
+	}
+
+	hash := count - n.offset
+
+	if sub, ok := n.cases["="+strconv.Itoa(count)]; ok {
+		return format(sb, sub, c, args, &hash)
+	}
+
+	category := PluralCategory(hash)
+	if n.kind == nodeSelectOrdinal {
+		category = OrdinalCategory(hash)
+	}
+
+	if sub, ok := n.cases[category]; ok {
+		return format(sb, sub, c, args, &hash)
+	}
+
+	if sub, ok := n.cases[Other]; ok {
+		return format(sb, sub, c, args, &hash)
+	}
+
+	if one, ok := n.cases[One]; ok && n.kind == nodePlural && c != nil {
+		return format(sb, pluralNodes(c, one), c, args, &hash)
+	}
+
+	return fmt.Errorf("icu: argument %q has no message for category %s", n.name, category)
+}
+
+// pluralNodes -- derive the other message from the one message by pluralizing the word
+// following #, or the last word of the message when it does not contain #.
+func pluralNodes(c *pluralize.Client, one []node) []node {
+	nodes := make([]node, len(one))
+	copy(nodes, one)
+
+	for i := range nodes {
+		if nodes[i].kind == nodeHash && i+1 < len(nodes) && nodes[i+1].kind == nodeText {
+			nodes[i+1].text = replaceWord(nodes[i+1].text, c.Plural, true)
+			return nodes
+		}
+	}
+
+	for i := len(nodes) - 1; i >= 0; i-- {
+		if nodes[i].kind == nodeText && len(strings.TrimSpace(nodes[i].text)) > 0 {
+			nodes[i].text = replaceWord(nodes[i].text, c.Plural, false)
+			break
+		}
+	}
+
+	return nodes
+}
+
+// replaceWord -- apply f to the first or last word in s.
+func replaceWord(s string, f func(string) string, first bool) string {
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || r == '\'' || r == '-'
+	}
+
+	var start, end int
+
+	if first {
+		start = strings.IndexFunc(s, isWord)
+		if start < 0 {
+			return s
+		}
+
+		end = strings.IndexFunc(s[start:], func(r rune) bool { return !isWord(r) })
+		if end < 0 {
+			end = len(s)
+		} else {
+			end += start
+		}
+	} else {
+		end = strings.LastIndexFunc(s, isWord)
+		if end < 0 {
+			return s
+		}
+
+		_, size := utf8.DecodeRuneInString(s[end:])
+		end += size
+		start = strings.LastIndexFunc(s[:end], func(r rune) bool { return !isWord(r) }) + 1
+	}
+
+	return s[:start] + f(s[start:end]) + s[end:]
+}
+
+// parser -- recursive descent parser over the message source.
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("icu: %s at offset %d", fmt.Sprintf(format, a...), p.pos)
+}
+
+func (p *parser) parseMessage(inPlural bool, nested bool) ([]node, error) {
+	var (
+		nodes []node
+		text  strings.Builder
+	)
+
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, node{kind: nodeText, text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for p.pos < len(p.src) {
+		ch := p.src[p.pos]
+
+		switch {
+		case ch == '\'':
+			p.parseQuoted(&text, inPlural)
+		case ch == '{':
+			flush()
+
+			n, err := p.parseArgument(inPlural)
+			if err != nil {
+				return nil, err
+			}
+
+			nodes = append(nodes, n)
+		case ch == '}':
+			if !nested {
+				return nil, p.errorf("unexpected }")
+			}
+
+			flush()
+
+			return nodes, nil
+		case ch == '#' && inPlural:
+			flush()
+			nodes = append(nodes, node{kind: nodeHash})
+			p.pos++
+		default:
+			text.WriteByte(ch)
+			p.pos++
+		}
+	}
+
+	if nested {
+		return nil, p.errorf("unterminated message")
+	}
+
+	flush()
+
+	return nodes, nil
+}
+
+// parseQuoted -- apostrophe quoting, a doubled apostrophe is a literal apostrophe and an apostrophe
+// preceding a syntax character starts a quoted literal ending at the next single apostrophe.
+func (p *parser) parseQuoted(text *strings.Builder, inPlural bool) {
+	p.pos++
+
+	if p.pos >= len(p.src) {
+		text.WriteByte('\'')
+		return
+	}
+
+	switch ch := p.src[p.pos]; {
+	case ch == '\'':
+		text.WriteByte('\'')
+		p.pos++
+
+		return
+	case ch == '{' || ch == '}' || ch == '|' || (ch == '#' && inPlural):
+	default:
+		text.WriteByte('\'')
+		return
+	}
+
+	for p.pos < len(p.src) {
+		if p.src[p.pos] == '\'' {
+			if strings.HasPrefix(p.src[p.pos:], `''`) {
+				text.WriteByte('\'')
+				p.pos += 2
+
+				continue
+			}
+
+			p.pos++
+
+			return
+		}
+
+		text.WriteByte(p.src[p.pos])
+		p.pos++
+	}
+}
+
+func (p *parser) parseArgument(inPlural bool) (node, error) {
+	p.pos++ // {
+	p.skipSpace()
+
+	name := p.parseIdent()
+	if len(name) == 0 {
+		return node{}, p.errorf("missing argument name")
+	}
+
+	p.skipSpace()
+
+	if p.consume('}') {
+		return node{kind: nodeArg, name: name}, nil
+	}
+
+	if !p.consume(',') {
+		return node{}, p.errorf("expected , or } after argument %q", name)
+	}
+
+	p.skipSpace()
+	argType := p.parseIdent()
+	p.skipSpace()
+
+	if p.consume('}') {
+		if argType == typePlural || argType == typeSelectOrdinal || argType == typeSelect {
+			return node{}, p.errorf("argument %q of type %s has no messages", name, argType)
+		}
+
+		return node{kind: nodeArg, name: name}, nil
+	}
+
+	if !p.consume(',') {
+		return node{}, p.errorf("expected , or } after argument type %q", argType)
+	}
+
+	switch argType {
+	case typePlural:
+		return p.parseCases(node{kind: nodePlural, name: name}, true)
+	case typeSelectOrdinal:
+		return p.parseCases(node{kind: nodeSelectOrdinal, name: name}, true)
+	case typeSelect:
+		return p.parseCases(node{kind: nodeSelect, name: name}, inPlural)
+	}
+
+	// Skip the style of other argument types (e.g. {n, number, integer}).
+	end := strings.IndexByte(p.src[p.pos:], '}')
+	if end < 0 {
+		return node{}, p.errorf("unterminated argument %q", name)
+	}
+
+	p.pos += end + 1
+
+	return node{kind: nodeArg, name: name}, nil
+}
+
+func (p *parser) parseCases(n node, inPlural bool) (node, error) {
+	n.cases = make(map[string][]node)
+
+	p.skipSpace()
+
+	if n.kind != nodeSelect && strings.HasPrefix(p.src[p.pos:], `offset:`) {
+		p.pos += len(`offset:`)
+		p.skipSpace()
+
+		start := p.pos
+		for p.pos < len(p.src) && p.src[p.pos] >= '0' && p.src[p.pos] <= '9' {
+			p.pos++
+		}
+
+		offset, err := strconv.Atoi(p.src[start:p.pos])
+		if err != nil {
+			return node{}, p.errorf("invalid offset for argument %q", n.name)
+		}
+
+		n.offset = offset
+	}
+
+	for {
+		p.skipSpace()
+
+		if p.consume('}') {
+			break
+		}
+
+		key := p.parseSelector()
+		if len(key) == 0 {
+			return node{}, p.errorf("missing selector for argument %q", n.name)
+		}
+
+		if err := p.validSelector(n, key); err != nil {
+			return node{}, err
+		}
+
+		p.skipSpace()
+
+		if !p.consume('{') {
+			return node{}, p.errorf("expected { after selector %q", key)
+		}
+
+		sub, err := p.parseMessage(inPlural, true)
+		if err != nil {
+			return node{}, err
+		}
+
+		p.pos++ // }
+
+		if _, ok := n.cases[key]; ok {
+			return node{}, p.errorf("duplicate selector %q for argument %q", key, n.name)
+		}
+
+		n.keys = append(n.keys, key)
+		n.cases[key] = sub
+	}
+
+	if len(n.keys) == 0 {
+		return node{}, p.errorf("argument %q has no messages", n.name)
+	}
+
+	return n, nil
+}
+
+func (p *parser) validSelector(n node, key string) error {
+	if n.kind == nodeSelect {
+		return nil
+	}
+
+	if strings.HasPrefix(key, `=`) {
+		if _, err := strconv.Atoi(key[1:]); err != nil {
+			return p.errorf("invalid selector %q for argument %q", key, n.name)
+		}
+
+		return nil
+	}
+
+	switch key {
+	case Zero, One, Two, Few, Many, Other:
+		return nil
+	}
+
+	return p.errorf("invalid selector %q for argument %q", key, n.name)
+}
+
+func (p *parser) parseIdent() string {
+	start := p.pos
+
+	for p.pos < len(p.src) {
+		ch := p.src[p.pos]
+		if !(ch == '_' || ch >= '0' && ch <= '9' || ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z') {
+			break
+		}
+		p.pos++
+	}
+
+	return p.src[start:p.pos]
+}
+
+func (p *parser) parseSelector() string {
+	start := p.pos
+
+	for p.pos < len(p.src) {
+		ch := p.src[p.pos]
+		if ch == '{' || ch == '}' || ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r' {
+			break
+		}
+		p.pos++
+	}
+
+	return p.src[start:p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && strings.IndexByte(" \t\n\r", p.src[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *parser) consume(ch byte) bool {
+	if p.pos < len(p.src) && p.src[p.pos] == ch {
+		p.pos++
+		return true
+	}
+
+	return false
+}
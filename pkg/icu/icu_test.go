@@ -0,0 +1,121 @@
+package icu //nolint:testpackage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/gertd/go-pluralize"
+	"github.com/gertd/go-pluralize/pkg/message"
+)
+
+func TestFormat(t *testing.T) { //nolint:funlen
+	const (
+		files    = `{n, plural, =0 {no files} one {# file} other {# files}}`
+		ordinal  = `{n, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}`
+		offset   = `{host} invited {n, plural, offset:1 =0 {nobody} =1 {{guest}} one {{guest} and # other} other {{guest} and # others}}`
+		fallback = `{n, plural, =0 {no files} one {# file was deleted}}`
+	)
+
+	tests := []struct {
+		src      string
+		args     message.Args
+		expected string
+	}{
+		{files, message.Args{"n": 0}, `no files`},
+		{files, message.Args{"n": 1}, `1 file`},
+		{files, message.Args{"n": 7}, `7 files`},
+		{files, message.Args{"n": float64(2)}, `2 files`},
+		{files, message.Args{"n": json.Number("1")}, `1 file`},
+		{ordinal, message.Args{"n": float64(22)}, `22nd`},
+		{ordinal, message.Args{"n": 1}, `1st`},
+		{ordinal, message.Args{"n": 2}, `2nd`},
+		{ordinal, message.Args{"n": 3}, `3rd`},
+		{ordinal, message.Args{"n": 4}, `4th`},
+		{ordinal, message.Args{"n": 11}, `11th`},
+		{ordinal, message.Args{"n": 12}, `12th`},
+		{ordinal, message.Args{"n": 13}, `13th`},
+		{ordinal, message.Args{"n": 21}, `21st`},
+		{ordinal, message.Args{"n": 102}, `102nd`},
+		{offset, message.Args{"host": "Ann", "guest": "Bob", "n": 0}, `Ann invited nobody`},
+		{offset, message.Args{"host": "Ann", "guest": "Bob", "n": 1}, `Ann invited Bob`},
+		{offset, message.Args{"host": "Ann", "guest": "Bob", "n": 2}, `Ann invited Bob and 1 other`},
+		{offset, message.Args{"host": "Ann", "guest": "Bob", "n": 5}, `Ann invited Bob and 4 others`},
+		{fallback, message.Args{"n": 0}, `no files`},
+		{fallback, message.Args{"n": 1}, `1 file was deleted`},
+		{fallback, message.Args{"n": 3}, `3 files was deleted`},
+		{`{n, plural, one {child}}`, message.Args{"n": 2}, `children`},
+		{`{n, plural, one {a box}}`, message.Args{"n": 2}, `a boxes`},
+		{`{g, select, male {He} female {She} other {They}} left`, message.Args{"g": "female"}, `She left`},
+		{`{g, select, male {He} female {She} other {They}} left`, message.Args{"g": "x"}, `They left`},
+		{`{n, plural, other {{g, select, a {# a} other {# b}}}}`, message.Args{"n": 2, "g": "a"}, `2 a`},
+		{`{n, number} items, # not replaced`, message.Args{"n": 3}, `3 items, # not replaced`},
+		{`It''s '{'literal'}' {n, plural, other {'#' is #}}`, message.Args{"n": 3}, `It's {literal} # is 3`},
+	}
+
+	c := pluralize.NewClient()
+
+	for i, test := range tests {
+		m, err := Parse(test.src)
+		if err != nil {
+			t.Errorf("FAIL test[%d] Parse(%s) error %v", i, test.src, err)
+			continue
+		}
+
+		actual, err := m.Format(c, test.args)
+		if err != nil {
+			t.Errorf("FAIL test[%d] Format(%s) error %v", i, test.src, err)
+			continue
+		}
+
+		if actual != test.expected {
+			t.Errorf("FAIL test[%d] Format(%s) expected %s, actual %s", i, test.src, test.expected, actual)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		`{`,
+		`}`,
+		`{n`,
+		`{n, plural}`,
+		`{n, plural,}`,
+		`{n, plural, one {x}`,
+		`{n, plural, some {x}}`,
+		`{n, plural, =x {x}}`,
+		`{n, plural, one {x} one {y}}`,
+		`{n, plural, offset:x one {x}}`,
+		`{n, plural, one x}`,
+		`{, plural, one {x}}`,
+	}
+
+	for i, src := range tests {
+		if _, err := Parse(src); err == nil {
+			t.Errorf("FAIL test[%d] Parse(%s) expected error", i, src)
+		}
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	c := pluralize.NewClient()
+
+	if _, err := MustParse(`{n, plural, one {# file}}`).Format(nil, message.Args{"n": 2}); err == nil {
+		t.Error("expected missing other message error without client")
+	}
+
+	if _, err := MustParse(`{n, selectordinal, one {#st}}`).Format(c, message.Args{"n": 2}); err == nil {
+		t.Error("expected missing other message error for selectordinal")
+	}
+
+	if _, err := MustParse(`{n, plural, other {#}}`).Format(c, message.Args{}); err == nil {
+		t.Error("expected missing argument error")
+	}
+
+	if _, err := MustParse(`{n, plural, other {#}}`).Format(c, message.Args{"n": 1.5}); err == nil {
+		t.Error("expected count conversion error")
+	}
+
+	if _, err := MustParse(`{n, plural, other {#}}`).Format(c, message.Args{"n": json.Number("1.5")}); err == nil {
+		t.Error("expected count conversion error")
+	}
+}
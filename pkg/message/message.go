@@ -0,0 +1,250 @@
+// Package message -- plural aware message templates.
+//
+// A template is plain text containing placeholders enclosed in braces:
+//
+//	{name}               the value of argument name
+//	{name|noun}          noun inflected by the count in argument name (e.g. file => files)
+//	{name|single|plural} explicit singular and plural forms selected by the count in argument name
+//
+// Literal braces are written as {{ and }}.
+//
+//	t := message.MustParse("Deleted {n} {n|file}")
+//	s, _ := t.Format(pluralize.NewClient(), message.Args{"n": 3}) // Deleted 3 files
+package message
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/gertd/go-pluralize"
+)
+
+// Args -- template arguments by name.
+type Args map[string]interface{}
+
+// Template -- compiled message template.
+type Template struct {
+	src   string
+	parts []part
+}
+
+// part -- literal text (name is empty) or placeholder.
+type part struct {
+	text  string
+	name  string
+	forms []string
+}
+
+// Parse -- compile a message template.
+func Parse(src string) (*Template, error) {
+	t := Template{src: src}
+
+	var text strings.Builder
+
+	for i := 0; i < len(src); i++ {
+		switch src[i] {
+		case '{':
+			if strings.HasPrefix(src[i:], `{{`) {
+				text.WriteByte('{')
+				i++
+
+				continue
+			}
+
+			end := strings.IndexByte(src[i:], '}')
+			if end < 0 {
+				return nil, fmt.Errorf("message: unterminated placeholder at offset %d", i)
+			}
+
+			p, err := parsePlaceholder(src[i+1:i+end], i)
+			if err != nil {
+				return nil, err
+			}
+
+			if text.Len() > 0 {
+				t.parts = append(t.parts, part{text: text.String()})
+				text.Reset()
+			}
+
+			t.parts = append(t.parts, p)
+			i += end
+		case '}':
+			if !strings.HasPrefix(src[i:], `}}`) {
+				return nil, fmt.Errorf("message: unexpected } at offset %d", i)
+			}
+
+			text.WriteByte('}')
+			i++
+		default:
+			text.WriteByte(src[i])
+		}
+	}
+
+	if text.Len() > 0 {
+		t.parts = append(t.parts, part{text: text.String()})
+	}
+
+	return &t, nil
+}
+
+// MustParse -- compile a message template, panics on error.
+func MustParse(src string) *Template {
+	t, err := Parse(src)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+// String -- return the template source.
+func (t *Template) String() string {
+	return t.src
+}
+
+// Execute -- write the template output for args to w.
+func (t *Template) Execute(w io.Writer, c *pluralize.Client, args Args) error {
+	s, err := t.Format(c, args)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, s)
+
+	return err
+}
+
+// Format -- return the template output for args.
+func (t *Template) Format(c *pluralize.Client, args Args) (string, error) {
+	var sb strings.Builder
+
+	for _, p := range t.parts {
+		if len(p.name) == 0 {
+			sb.WriteString(p.text)
+			continue
+		}
+
+		value, ok := args[p.name]
+		if !ok {
+			return ``, fmt.Errorf("message: missing argument %q", p.name)
+		}
+
+		if len(p.forms) == 0 {
+			fmt.Fprint(&sb, value)
+			continue
+		}
+
+		count, err := Count(value)
+		if err != nil {
+			return ``, fmt.Errorf("message: argument %q: %w", p.name, err)
+		}
+
+		switch {
+		case len(p.forms) == 1:
+			sb.WriteString(c.Pluralize(p.forms[0], count, false))
+		case count == 1:
+			sb.WriteString(p.forms[0])
+		default:
+			sb.WriteString(p.forms[1])
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// Count -- convert an argument value to a count, floating point and json.Number values (e.g. decoded from JSON) must be integral.
+func Count(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case int8:
+		return int(v), nil
+	case int16:
+		return int(v), nil
+	case int32:
+		return int(v), nil
+	case int64:
+		return int(v), nil
+	case uint:
+		return int(v), nil
+	case uint8:
+		return int(v), nil
+	case uint16:
+		return int(v), nil
+	case uint32:
+		return int(v), nil
+	case uint64:
+		return int(v), nil
+	case float32:
+		return floatCount(float64(v))
+	case float64:
+		return floatCount(v)
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return int(n), nil
+		}
+
+		f, err := v.Float64()
+		if err != nil {
+			return 0, err
+		}
+
+		return floatCount(f)
+	case string:
+		return strconv.Atoi(v)
+	default:
+		return 0, fmt.Errorf("value %v of type %T is not a count", value, value)
+	}
+}
+
+// floatCount -- convert an integral floating point value (e.g. a number decoded from JSON) to a count.
+func floatCount(f float64) (int, error) {
+	if f != math.Trunc(f) || math.Abs(f) >= math.MaxInt {
+		return 0, fmt.Errorf("value %v is not an integral count", f)
+	}
+
+	return int(f), nil
+}
+
+func parsePlaceholder(s string, offset int) (part, error) {
+	fields := strings.Split(s, `|`)
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	name := fields[0]
+	if !isName(name) {
+		return part{}, fmt.Errorf("message: invalid argument name %q at offset %d", name, offset)
+	}
+
+	forms := fields[1:]
+	if len(forms) > 2 {
+		return part{}, fmt.Errorf("message: too many forms for argument %q at offset %d", name, offset)
+	}
+
+	for _, f := range forms {
+		if len(f) == 0 {
+			return part{}, fmt.Errorf("message: empty form for argument %q at offset %d", name, offset)
+		}
+	}
+
+	return part{name: name, forms: forms}, nil
+}
+
+func isName(s string) bool {
+	if len(s) == 0 {
+		return false
+	}
+
+	for _, r := range s {
+		if !(r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
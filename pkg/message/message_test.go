@@ -0,0 +1,134 @@
+package message //nolint:testpackage
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/gertd/go-pluralize"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		src      string
+		args     Args
+		expected string
+	}{
+		{`Deleted {n} {n|file}`, Args{"n": 1}, `Deleted 1 file`},
+		{`Deleted {n} {n|file}`, Args{"n": 3}, `Deleted 3 files`},
+		{`Deleted {n} {n|file}`, Args{"n": 0}, `Deleted 0 files`},
+		{`{n} {n|child} {n|is|are} here`, Args{"n": 2}, `2 children are here`},
+		{`{n} {n|child} {n|is|are} here`, Args{"n": 1}, `1 child is here`},
+		{`{ n | Box }`, Args{"n": int64(2)}, `Boxes`},
+		{`{n|person}`, Args{"n": "4"}, `people`},
+		{`{user} has {n} {n|message}`, Args{"user": "Ann", "n": uint(1)}, `Ann has 1 message`},
+		{`{n} {n|file}`, Args{"n": float64(2)}, `2 files`},
+		{`{n} {n|file}`, Args{"n": float32(1)}, `1 file`},
+		{`{n} {n|file}`, Args{"n": json.Number("3")}, `3 files`},
+		{`{{literal}} {n}`, Args{"n": 5}, `{literal} 5`},
+		{`no placeholders`, nil, `no placeholders`},
+	}
+
+	c := pluralize.NewClient()
+
+	for i, test := range tests {
+		tmpl, err := Parse(test.src)
+		if err != nil {
+			t.Errorf("FAIL test[%d] Parse(%s) error %v", i, test.src, err)
+			continue
+		}
+
+		actual, err := tmpl.Format(c, test.args)
+		if err != nil {
+			t.Errorf("FAIL test[%d] Format(%s) error %v", i, test.src, err)
+			continue
+		}
+
+		if actual != test.expected {
+			t.Errorf("FAIL test[%d] Format(%s) expected %s, actual %s", i, test.src, test.expected, actual)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		`{n`,
+		`n}`,
+		`{}`,
+		`{n-1}`,
+		`{n|a|b|c}`,
+		`{n|}`,
+	}
+
+	for i, src := range tests {
+		if _, err := Parse(src); err == nil {
+			t.Errorf("FAIL test[%d] Parse(%s) expected error", i, src)
+		}
+	}
+}
+
+func TestFormatErrors(t *testing.T) {
+	c := pluralize.NewClient()
+
+	if _, err := MustParse(`{n}`).Format(c, Args{}); err == nil {
+		t.Error("expected missing argument error")
+	}
+
+	if _, err := MustParse(`{n|file}`).Format(c, Args{"n": "many"}); err == nil {
+		t.Error("expected count conversion error")
+	}
+}
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		value    interface{}
+		expected int
+		ok       bool
+	}{
+		{2, 2, true},
+		{float64(2), 2, true},
+		{float32(-1), -1, true},
+		{json.Number("3"), 3, true},
+		{json.Number("4.0"), 4, true},
+		{"5", 5, true},
+		{1.5, 0, false},
+		{math.NaN(), 0, false},
+		{math.Inf(1), 0, false},
+		{json.Number("1.5"), 0, false},
+		{json.Number("x"), 0, false},
+		{true, 0, false},
+	}
+
+	for i, test := range tests {
+		actual, err := Count(test.value)
+		if (err == nil) != test.ok || actual != test.expected {
+			t.Errorf("FAIL test[%d] Count(%v) expected %d/%t, actual %d/%v", i, test.value, test.expected, test.ok, actual, err)
+		}
+	}
+}
+
+func TestFormatJSONArgs(t *testing.T) {
+	var args Args
+
+	if err := json.Unmarshal([]byte(`{"n": 2}`), &args); err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := MustParse(`{n} {n|file}`).Format(pluralize.NewClient(), args)
+	if err != nil || actual != `2 files` {
+		t.Errorf("expected 2 files, actual %s %v", actual, err)
+	}
+}
+
+func TestExecute(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := MustParse(`{n} {n|file}`).Execute(&buf, pluralize.NewClient(), Args{"n": 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	if buf.String() != `2 files` {
+		t.Errorf("expected 2 files, actual %s", buf.String())
+	}
+}
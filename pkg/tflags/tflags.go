@@ -14,7 +14,8 @@ const (
 	TestCmdIsSingular
 	TestCmdPlural
 	TestCmdSingular
-	TestCmdAll = TestCmdIsPlural + TestCmdIsSingular + TestCmdPlural + TestCmdSingular
+	TestCmdPluralize
+	TestCmdAll = TestCmdIsPlural + TestCmdIsSingular + TestCmdPlural + TestCmdSingular + TestCmdPluralize
 )
 
 // TestCmd -- string constants.
@@ -24,6 +25,7 @@ const (
 	testCmdIsSingular = "IsSingular"
 	testCmdPlural     = "Plural"
 	testCmdSingular   = "Singular"
+	testCmdPluralize  = "Pluralize"
 	testCmdAll        = "All"
 )
 
@@ -36,6 +38,7 @@ func testCmdID(t TestCmd) string {
 		TestCmdIsSingular: testCmdIsSingular,
 		TestCmdPlural:     testCmdPlural,
 		TestCmdSingular:   testCmdSingular,
+		TestCmdPluralize:  testCmdPluralize,
 		TestCmdAll:        testCmdAll,
 	}
 
@@ -64,6 +67,7 @@ func testCmdName(s string) TestCmd {
 			strings.ToLower(testCmdIsSingular): TestCmdIsSingular,
 			strings.ToLower(testCmdPlural):     TestCmdPlural,
 			strings.ToLower(testCmdSingular):   TestCmdSingular,
+			strings.ToLower(testCmdPluralize):  TestCmdPluralize,
 			strings.ToLower(testCmdAll):        TestCmdAll,
 		}
 
@@ -11,16 +11,26 @@ import (
 type Rule struct {
 	expression  *regexp.Regexp
 	replacement string
+	uncountable bool
 }
 
 // Client -- pluralize client.
 type Client struct {
-	pluralRules      []Rule
-	singularRules    []Rule
-	uncountables     map[string]bool
-	irregularSingles map[string]string
-	irregularPlurals map[string]string
-	interpolateExpr  *regexp.Regexp
+	pluralRules          []Rule
+	singularRules        []Rule
+	articleRules         []Rule
+	uncountables         map[string]bool
+	irregularSingles     map[string]string
+	irregularPlurals     map[string]string
+	irregularVerbSingles map[string]string
+	irregularVerbPlurals map[string]string
+	thirdPersonVerbRules []Rule
+	baseVerbRules        []Rule
+	invariantVerbRules   []Rule
+	notNouns             map[string]bool
+	adjectives           map[string]bool
+	interpolateExpr      *regexp.Regexp
+	possessiveStyle      PossessiveStyle
 }
 
 // NewClient - pluralization client factory method.
@@ -34,14 +44,26 @@ func NewClient() *Client {
 func (c *Client) init() {
 	c.pluralRules = make([]Rule, 0)
 	c.singularRules = make([]Rule, 0)
+	c.articleRules = make([]Rule, 0)
 	c.uncountables = make(map[string]bool)
 	c.irregularSingles = make(map[string]string)
 	c.irregularPlurals = make(map[string]string)
+	c.irregularVerbSingles = make(map[string]string)
+	c.irregularVerbPlurals = make(map[string]string)
+	c.thirdPersonVerbRules = make([]Rule, 0)
+	c.baseVerbRules = make([]Rule, 0)
+	c.invariantVerbRules = make([]Rule, 0)
+	c.notNouns = make(map[string]bool)
+	c.adjectives = make(map[string]bool)
 
 	c.loadIrregularRules()
 	c.loadPluralizationRules()
 	c.loadSingularizationRules()
 	c.loadUncountableRules()
+	c.loadArticleRules()
+	c.loadVerbRules()
+	c.loadNotNounRules()
+	c.loadAdjectiveRules()
 	c.interpolateExpr = regexp.MustCompile(`\$(\d{1,2})`)
 }
 
@@ -87,12 +109,12 @@ func (c *Client) IsSingular(word string) bool {
 
 // AddPluralRule -- Add a pluralization rule to the collection.
 func (c *Client) AddPluralRule(rule string, replacement string) {
-	c.pluralRules = append(c.pluralRules, Rule{sanitizeRule(rule), replacement})
+	c.pluralRules = append(c.pluralRules, Rule{expression: sanitizeRule(rule), replacement: replacement})
 }
 
 // AddSingularRule -- Add a singularization rule to the collection.
 func (c *Client) AddSingularRule(rule string, replacement string) {
-	c.singularRules = append(c.singularRules, Rule{sanitizeRule(rule), replacement})
+	c.singularRules = append(c.singularRules, Rule{expression: sanitizeRule(rule), replacement: replacement})
 }
 
 // AddUncountableRule -- Add an uncountable word rule.
@@ -102,8 +124,10 @@ func (c *Client) AddUncountableRule(word string) {
 		return
 	}
 
-	c.AddPluralRule(word, `$0`)
-	c.AddSingularRule(word, `$0`)
+	rule := Rule{expression: sanitizeRule(word), replacement: `$0`, uncountable: true}
+
+	c.pluralRules = append(c.pluralRules, rule)
+	c.singularRules = append(c.singularRules, rule)
 }
 
 // AddIrregularRule -- Add an irregular word definition.
@@ -215,8 +239,8 @@ func sanitizeRule(rule string) *regexp.Regexp {
 }
 
 func restoreCase(word string, token string) string {
-	// Tokens are an exact match.
-	if word == token {
+	// Tokens are an exact match or empty.
+	if word == token || len(token) == 0 {
 		return token
 	}
 
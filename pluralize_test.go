@@ -15,9 +15,11 @@ type TestEntry struct {
 }
 
 type params struct {
-	passLog *bool
-	word    *string
-	cmd     *string
+	passLog   *bool
+	word      *string
+	cmd       *string
+	count     *int
+	inclusive *bool
 }
 
 var (
@@ -28,6 +30,8 @@ func TestMain(m *testing.M) {
 	p.passLog = flag.Bool("pass", false, "log PASS results")
 	p.word = flag.String("word", "", "input value")
 	p.cmd = flag.String("cmd", "all", "command name [optional]")
+	p.count = flag.Int("count", 1, "count for the Pluralize command [optional]")
+	p.inclusive = flag.Bool("inclusive", false, "inclusive Pluralize command [optional]")
 
 	flag.Parse()
 
@@ -56,7 +60,7 @@ func TestCmd(t *testing.T) {
 	testCmd := tflags.TestCmdString(*p.cmd)
 
 	if testCmd.Has(tflags.TestCmdUnknown) {
-		t.Error(fmt.Errorf("unknown -cmd value %s, valid [All|IsPlural|IsSingular|Plural|Singular]", *p.cmd))
+		t.Error(fmt.Errorf("unknown -cmd value %s, valid [All|IsPlural|IsSingular|Plural|Singular|Pluralize]", *p.cmd))
 		return
 	}
 
@@ -77,6 +81,11 @@ func TestCmd(t *testing.T) {
 	if testCmd.Has(tflags.TestCmdSingular) {
 		t.Logf("Singular(%s)   => %s\n", *p.word, pluralize.Singular(*p.word))
 	}
+
+	if testCmd.Has(tflags.TestCmdPluralize) {
+		t.Logf("Pluralize(%s, %d, %t) => %s\n", *p.word, *p.count, *p.inclusive,
+			pluralize.Pluralize(*p.word, *p.count, *p.inclusive))
+	}
 }
 
 func TestIsPlural(t *testing.T) {
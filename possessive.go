@@ -0,0 +1,56 @@
+package pluralize
+
+import (
+	"strings"
+)
+
+// PossessiveStyle -- enum, controls the possessive form of singular words ending in `s`.
+type PossessiveStyle uint8
+
+// PossessiveStyle -- enum constants.
+const (
+	// PossessiveStyleStandard -- always append `'s` to singular words (e.g. boss's, virus's).
+	PossessiveStyleStandard PossessiveStyle = iota
+	// PossessiveStyleTraditional -- append only `'` to singular words ending in `s` (e.g. boss', virus').
+	PossessiveStyleTraditional
+)
+
+// SetPossessiveStyle -- Set the possessive style used for singular words ending in `s`.
+func (c *Client) SetPossessiveStyle(style PossessiveStyle) {
+	c.possessiveStyle = style
+}
+
+// Possessive -- Return the possessive form of a word (e.g. boss's, bosses', children's).
+// Plurality is guessed from the word, most names ending in `s` (e.g. James, Jones) are
+// indistinguishable from regular plurals and get the plural form (James'), use
+// SingularPossessive for names and other words known to be singular.
+func (c *Client) Possessive(word string) string {
+	return c.possessive(word, c.IsPlural(word) && !c.IsSingular(word))
+}
+
+// SingularPossessive -- Return the possessive form of a word known to be singular, such as a
+// name, following the possessive style (e.g. James's or James').
+func (c *Client) SingularPossessive(word string) string {
+	return c.possessive(word, false)
+}
+
+// PluralPossessive -- Return the possessive form of the plural of a word (e.g. boss => bosses').
+func (c *Client) PluralPossessive(word string) string {
+	return c.possessive(c.Plural(word), true)
+}
+
+func (c *Client) possessive(word string, plural bool) string {
+	if len(word) == 0 {
+		return word
+	}
+
+	if !strings.HasSuffix(strings.ToLower(word), `s`) {
+		return word + restoreCase(word, `'s`)
+	}
+
+	if plural || c.possessiveStyle == PossessiveStyleTraditional {
+		return word + `'`
+	}
+
+	return word + restoreCase(word, `'s`)
+}
@@ -0,0 +1,124 @@
+package pluralize //nolint:testpackage
+
+import (
+	"testing"
+)
+
+func TestPossessive(t *testing.T) {
+	tests := []TestEntry{
+		{`user`, `user's`},
+		{`users`, `users'`},
+		{`boss`, `boss's`},
+		{`bosses`, `bosses'`},
+		{`child`, `child's`},
+		{`children`, `children's`},
+		{`sheep`, `sheep's`},
+		{`Bus`, `Bus's`},
+		{`Dog`, `Dog's`},
+		{`DOGS`, `DOGS'`},
+		{`CAT`, `CAT'S`},
+		{``, ``},
+	}
+	passed := 0
+	failed := 0
+
+	pluralize := NewClient()
+
+	for i, testItem := range tests {
+		if actual := pluralize.Possessive(testItem.input); actual == testItem.expected {
+			plogf(t, "PASS test[%d] func %s(%s) expected %s, actual %s", i, "Possessive",
+				testItem.input, testItem.expected, actual)
+			passed++
+		} else {
+			t.Errorf("FAIL test[%d] func %s(%s) expected %s, actual %s", i, "Possessive",
+				testItem.input, testItem.expected, actual)
+			failed++
+		}
+	}
+
+	slog("TestPossessive", passed, failed, len(tests))
+}
+
+func TestPluralPossessive(t *testing.T) {
+	tests := []TestEntry{
+		{`user`, `users'`},
+		{`boss`, `bosses'`},
+		{`child`, `children's`},
+		{`person`, `people's`},
+		{`sheep`, `sheep's`},
+		{`Box`, `Boxes'`},
+	}
+	passed := 0
+	failed := 0
+
+	pluralize := NewClient()
+
+	for i, testItem := range tests {
+		if actual := pluralize.PluralPossessive(testItem.input); actual == testItem.expected {
+			plogf(t, "PASS test[%d] func %s(%s) expected %s, actual %s", i, "PluralPossessive",
+				testItem.input, testItem.expected, actual)
+			passed++
+		} else {
+			t.Errorf("FAIL test[%d] func %s(%s) expected %s, actual %s", i, "PluralPossessive",
+				testItem.input, testItem.expected, actual)
+			failed++
+		}
+	}
+
+	slog("TestPluralPossessive", passed, failed, len(tests))
+}
+
+func TestPossessiveStyle(t *testing.T) {
+	pluralize := NewClient()
+
+	if pluralize.Possessive(`boss`) != `boss's` {
+		t.Fail()
+	}
+
+	pluralize.SetPossessiveStyle(PossessiveStyleTraditional)
+
+	if pluralize.Possessive(`boss`) != `boss'` {
+		t.Fail()
+	}
+
+	if pluralize.Possessive(`user`) != `user's` {
+		t.Fail()
+	}
+
+	if pluralize.PluralPossessive(`child`) != `children's` {
+		t.Fail()
+	}
+}
+
+func TestSingularPossessive(t *testing.T) {
+	tests := []struct {
+		word        string
+		standard    string
+		traditional string
+	}{
+		{`James`, `James's`, `James'`},
+		{`Charles`, `Charles's`, `Charles'`},
+		{`JONES`, `JONES'S`, `JONES'`},
+		{`Ann`, `Ann's`, `Ann's`},
+		{``, ``, ``},
+	}
+
+	standard, traditional := NewClient(), NewClient()
+	traditional.SetPossessiveStyle(PossessiveStyleTraditional)
+
+	for i, test := range tests {
+		if actual := standard.SingularPossessive(test.word); actual != test.standard {
+			t.Errorf("FAIL test[%d] standard SingularPossessive(%s) expected %s, actual %s", i, test.word, test.standard, actual)
+		}
+
+		if actual := traditional.SingularPossessive(test.word); actual != test.traditional {
+			t.Errorf("FAIL test[%d] traditional SingularPossessive(%s) expected %s, actual %s", i, test.word,
+				test.traditional, actual)
+		}
+	}
+
+	// Possessive guesses names ending in s are plural.
+	if actual := standard.Possessive(`James`); actual != `James'` {
+		t.Errorf("FAIL Possessive(James) expected James', actual %s", actual)
+	}
+}
@@ -0,0 +1,34 @@
+package pluralize
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ReplaceNoun -- Replace the singular and plural forms of a noun in text, in any case, with the
+// matching form of the replacement, restoring the case of each match (e.g. Workspaces => Projects).
+// An empty replacement removes the matches.
+func (c *Client) ReplaceNoun(text string, from string, to string) string {
+	if len(from) == 0 {
+		return text
+	}
+
+	fromSingular, fromPlural := c.Singular(strings.ToLower(from)), c.Plural(strings.ToLower(from))
+	toSingular, toPlural := c.Singular(to), c.Plural(to)
+
+	// Longest alternative first, the regexp alternation is leftmost first.
+	alternatives := []string{bounded(fromPlural), bounded(fromSingular)}
+	if len(fromSingular) > len(fromPlural) {
+		alternatives[0], alternatives[1] = alternatives[1], alternatives[0]
+	}
+
+	expr := regexp.MustCompile(`(?i)(?:` + strings.Join(alternatives, `|`) + `)`)
+
+	return expr.ReplaceAllStringFunc(text, func(match string) string {
+		if !strings.EqualFold(match, fromSingular) {
+			return restoreCase(match, toPlural)
+		}
+
+		return restoreCase(match, toSingular)
+	})
+}
@@ -0,0 +1,37 @@
+package pluralize //nolint:testpackage
+
+import (
+	"testing"
+)
+
+func TestReplaceNoun(t *testing.T) {
+	tests := []struct {
+		text     string
+		from     string
+		to       string
+		expected string
+	}{
+		{`Open the workspace.`, `workspace`, `project`, `Open the project.`},
+		{`Workspaces: list all workspaces`, `workspace`, `project`, `Projects: list all projects`},
+		{`WORKSPACE and Workspace's files`, `workspaces`, `project`, `PROJECT and Project's files`},
+		{`workspaceID and myworkspace stay`, `workspace`, `project`, `workspaceID and myworkspace stay`},
+		{`one child, two children`, `child`, `person`, `one person, two people`},
+		{`A Person and some PEOPLE`, `person`, `member`, `A Member and some MEMBERS`},
+		{`Mouse mice`, `mouse`, `goose`, `Goose geese`},
+		{`the work order and work orders`, `work order`, `ticket`, `the ticket and tickets`},
+		{`a.b (regex) a.bs axb`, `a.b`, `item`, `item (regex) items axb`},
+		{`C++ and c++s`, `c++`, `rust`, `RUST and rusts`},
+		{`.NET apps and .nets`, `.net`, `java`, `JAVA apps and javas`},
+		{`Workspace and workspaces`, `workspace`, ``, ` and `},
+		{`unchanged`, ``, `x`, `unchanged`},
+	}
+
+	pluralize := NewClient()
+
+	for i, test := range tests {
+		if actual := pluralize.ReplaceNoun(test.text, test.from, test.to); actual != test.expected {
+			t.Errorf("FAIL test[%d] ReplaceNoun(%s, %s, %s) expected %s, actual %s", i,
+				test.text, test.from, test.to, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package pluralize
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Rule file keywords.
+const (
+	RuleKindPlural      = "plural"
+	RuleKindSingular    = "singular"
+	RuleKindIrregular   = "irregular"
+	RuleKindUncountable = "uncountable"
+)
+
+// RuleError -- rule file validation error.
+type RuleError struct {
+	Line int
+	Err  error
+}
+
+// Error -- implements error.
+func (e RuleError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// RuleErrors -- all validation errors of a rule file.
+type RuleErrors []RuleError
+
+// Error -- implements error, one line per validation error.
+func (e RuleErrors) Error() string {
+	s := make([]string, len(e))
+	for i, err := range e {
+		s[i] = err.Error()
+	}
+
+	return strings.Join(s, "\n")
+}
+
+// LoadRules -- Load rule definitions, one per line, adding them to the client in file order.
+// Empty lines and lines starting with # are ignored, fields are separated by white space and
+// may be double quoted (Go string syntax) to contain white space or be empty.
+//
+//	plural      <rule> <replacement>
+//	singular    <rule> <replacement>
+//	irregular   <single> <plural>
+//	uncountable <word or rule>
+//
+// The file is validated before any rule is added, on error the client is unchanged and
+// a RuleErrors value lists the errors with their line numbers.
+func (c *Client) LoadRules(r io.Reader) error {
+	var (
+		apply []func()
+		errs  RuleErrors
+		line  int
+	)
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line++
+
+		text := strings.TrimSpace(scanner.Text())
+		if len(text) == 0 || strings.HasPrefix(text, `#`) {
+			continue
+		}
+
+		f, err := c.parseRuleLine(text)
+		if err != nil {
+			errs = append(errs, RuleError{Line: line, Err: err})
+			continue
+		}
+
+		apply = append(apply, f)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+
+	for _, f := range apply {
+		f()
+	}
+
+	return nil
+}
+
+// parseRuleLine -- validate a rule line, returning the function adding the rule.
+func (c *Client) parseRuleLine(text string) (func(), error) {
+	fields, err := splitRuleFields(text)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, args := strings.ToLower(fields[0]), fields[1:]
+
+	expect := map[string]int{
+		RuleKindPlural:      2,
+		RuleKindSingular:    2,
+		RuleKindIrregular:   2,
+		RuleKindUncountable: 1,
+	}
+
+	n, ok := expect[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown rule kind %q, valid [%s|%s|%s|%s]", fields[0],
+			RuleKindPlural, RuleKindSingular, RuleKindIrregular, RuleKindUncountable)
+	}
+
+	if len(args) != n {
+		return nil, fmt.Errorf("%s rule expects %d fields, got %d", kind, n, len(args))
+	}
+
+	if len(args[0]) == 0 {
+		return nil, fmt.Errorf("%s rule has an empty word or rule", kind)
+	}
+
+	switch kind {
+	case RuleKindPlural:
+		if err := c.validateRule(args[0], args[1]); err != nil {
+			return nil, err
+		}
+
+		return func() { c.AddPluralRule(args[0], args[1]) }, nil
+	case RuleKindSingular:
+		if err := c.validateRule(args[0], args[1]); err != nil {
+			return nil, err
+		}
+
+		return func() { c.AddSingularRule(args[0], args[1]) }, nil
+	case RuleKindIrregular:
+		if len(args[1]) == 0 {
+			return nil, fmt.Errorf("%s rule has an empty plural", kind)
+		}
+
+		return func() { c.AddIrregularRule(args[0], args[1]) }, nil
+	default:
+		if isExpr(args[0]) {
+			if _, err := compileRule(args[0]); err != nil {
+				return nil, err
+			}
+		}
+
+		return func() { c.AddUncountableRule(args[0]) }, nil
+	}
+}
+
+// validateRule -- compile the rule and check the replacement only references existing capture groups.
+func (c *Client) validateRule(rule string, replacement string) error {
+	expr, err := compileRule(rule)
+	if err != nil {
+		return err
+	}
+
+	for _, submatch := range c.interpolateExpr.FindAllStringSubmatch(replacement, -1) {
+		if n, _ := strconv.Atoi(submatch[1]); n > expr.NumSubexp() {
+			return fmt.Errorf("replacement %s references %s, rule %s has %d capture groups",
+				replacement, submatch[0], rule, expr.NumSubexp())
+		}
+	}
+
+	return nil
+}
+
+// splitRuleFields -- split a rule line into white space separated, optionally double quoted, fields.
+func splitRuleFields(text string) ([]string, error) {
+	var fields []string
+
+	for text = strings.TrimSpace(text); len(text) > 0; text = strings.TrimSpace(text) {
+		if text[0] != '"' {
+			end := strings.IndexAny(text, " \t")
+			if end < 0 {
+				end = len(text)
+			}
+
+			fields = append(fields, text[:end])
+			text = text[end:]
+
+			continue
+		}
+
+		quoted, err := strconv.QuotedPrefix(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quoted field %s", text)
+		}
+
+		field, _ := strconv.Unquote(quoted)
+		fields = append(fields, field)
+		text = text[len(quoted):]
+	}
+
+	return fields, nil
+}
+
+// compileRule -- compile a rule like sanitizeRule, returning an error instead of panicking.
+func compileRule(rule string) (*regexp.Regexp, error) {
+	if isExpr(rule) {
+		return regexp.Compile(rule)
+	}
+
+	return regexp.Compile(`(?i)^` + rule + `$`)
+}
@@ -0,0 +1,80 @@
+package pluralize //nolint:testpackage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadRules(t *testing.T) {
+	pluralize := NewClient()
+
+	src := `
+# custom rules
+irregular   person  humans
+uncountable widget
+plural      (?i)(octop)us$   $1odes
+singular    "(?i)(octop)odes$" "$1us"
+singular    "(?i)^zzz$"  ""
+`
+
+	if err := pluralize.LoadRules(strings.NewReader(src)); err != nil {
+		t.Fatalf("FAIL LoadRules %v", err)
+	}
+
+	tests := []struct {
+		actual   string
+		expected string
+	}{
+		{pluralize.Plural(`person`), `humans`},
+		{pluralize.Singular(`humans`), `person`},
+		{pluralize.Plural(`Widget`), `Widget`},
+		{pluralize.Plural(`octopus`), `octopodes`},
+		{pluralize.Singular(`octopodes`), `octopus`},
+		{pluralize.Singular(`zzz`), ``},
+	}
+
+	for i, test := range tests {
+		if test.actual != test.expected {
+			t.Errorf("FAIL test[%d] expected %q, actual %q", i, test.expected, test.actual)
+		}
+	}
+}
+
+func TestLoadRulesErrors(t *testing.T) {
+	pluralize := NewClient()
+
+	src := `irregular goose
+uncountable widget
+plural (?i)(bad$ x
+# comment
+
+bogus word
+singular "unterminated x
+irregular "" geese
+plural (?i)(octop)us$ $1$2
+singular (?i)x$ $1
+`
+
+	err := pluralize.LoadRules(strings.NewReader(src))
+
+	errs, ok := err.(RuleErrors) //nolint:errorlint
+	if !ok {
+		t.Fatalf("FAIL LoadRules expected RuleErrors, actual %v", err)
+	}
+
+	lines := []int{1, 3, 6, 7, 8, 9, 10}
+	if len(errs) != len(lines) {
+		t.Fatalf("FAIL LoadRules expected %d errors, actual %d\n%v", len(lines), len(errs), err)
+	}
+
+	for i, line := range lines {
+		if errs[i].Line != line || !strings.HasPrefix(errs[i].Error(), `line `) {
+			t.Errorf("FAIL error[%d] expected line %d, actual %v", i, line, errs[i])
+		}
+	}
+
+	// The client is unchanged when the file has errors.
+	if actual := pluralize.Plural(`widget`); actual != `widgets` {
+		t.Errorf("FAIL Plural(widget) expected widgets, actual %s", actual)
+	}
+}
@@ -0,0 +1,132 @@
+package pluralize
+
+import (
+	"bytes"
+	"io"
+)
+
+// MaxMarkerLen -- maximum length of a marked token when streaming, longer markers are written unchanged.
+const MaxMarkerLen = 4096
+
+// TextWriter -- io.WriteCloser inflecting marked tokens (e.g. [[dog]]) across Write boundaries.
+// Close must be called to flush a trailing incomplete marker, it does not close the underlying writer.
+type TextWriter struct {
+	w io.Writer
+	t textTransformer
+}
+
+// PluralizeWriter -- return a writer pluralizing marked tokens written to w, see PluralizeText.
+func (c *Client) PluralizeWriter(w io.Writer) *TextWriter {
+	return &TextWriter{w: w, t: textTransformer{inflect: c.Plural}}
+}
+
+// SingularizeWriter -- return a writer singularizing marked tokens written to w, see SingularizeText.
+func (c *Client) SingularizeWriter(w io.Writer) *TextWriter {
+	return &TextWriter{w: w, t: textTransformer{inflect: c.Singular}}
+}
+
+// Write -- implements io.Writer.
+func (tw *TextWriter) Write(p []byte) (int, error) {
+	if _, err := tw.w.Write(tw.t.transform(p, false)); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Close -- flush pending output.
+func (tw *TextWriter) Close() error {
+	_, err := tw.w.Write(tw.t.transform(nil, true))
+	return err
+}
+
+// TextReader -- io.Reader inflecting marked tokens (e.g. [[dog]]) read from an underlying reader.
+type TextReader struct {
+	r   io.Reader
+	t   textTransformer
+	buf []byte
+	out []byte
+	err error
+}
+
+// PluralizeReader -- return a reader pluralizing marked tokens read from r, see PluralizeText.
+func (c *Client) PluralizeReader(r io.Reader) *TextReader {
+	return &TextReader{r: r, t: textTransformer{inflect: c.Plural}, buf: make([]byte, MaxMarkerLen)}
+}
+
+// SingularizeReader -- return a reader singularizing marked tokens read from r, see SingularizeText.
+func (c *Client) SingularizeReader(r io.Reader) *TextReader {
+	return &TextReader{r: r, t: textTransformer{inflect: c.Singular}, buf: make([]byte, MaxMarkerLen)}
+}
+
+// Read -- implements io.Reader.
+func (tr *TextReader) Read(p []byte) (int, error) {
+	for len(tr.out) == 0 {
+		if tr.err != nil {
+			return 0, tr.err
+		}
+
+		n, err := tr.r.Read(tr.buf)
+		tr.err = err
+		tr.out = tr.t.transform(tr.buf[:n], err != nil)
+	}
+
+	n := copy(p, tr.out)
+	tr.out = tr.out[n:]
+
+	return n, nil
+}
+
+// textTransformer -- incremental equivalent of inflectText, holding back a possible incomplete marker.
+type textTransformer struct {
+	inflect func(string) string
+	pending []byte
+}
+
+// transform -- append p to the pending input and return the output which is complete,
+// at the end of the input (final) all pending input is returned.
+func (t *textTransformer) transform(p []byte, final bool) []byte {
+	buf := append(t.pending, p...) //nolint:gocritic
+	out := make([]byte, 0, len(buf))
+
+	openDelim, closeDelim := []byte(MarkerOpen), []byte(MarkerClose)
+
+	i := 0
+	for i < len(buf) {
+		j := bytes.Index(buf[i:], openDelim)
+		if j < 0 {
+			end := len(buf)
+			if !final && buf[end-1] == openDelim[0] {
+				end--
+			}
+
+			out = append(out, buf[i:end]...)
+			i = end
+
+			break
+		}
+
+		out = append(out, buf[i:i+j]...)
+		i += j
+
+		start := i + len(openDelim)
+		if k := bytes.Index(buf[start:], closeDelim); k >= 0 {
+			out = append(out, inflectMarked(string(buf[start:start+k]), t.inflect)...)
+			i = start + k + len(closeDelim)
+
+			continue
+		}
+
+		if !final && len(buf)-i <= MaxMarkerLen {
+			break
+		}
+
+		// Unterminated marker, write the first delimiter byte and continue scanning.
+		out = append(out, buf[i])
+		i++
+	}
+
+	t.pending = append([]byte(nil), buf[i:]...)
+
+	return out
+}
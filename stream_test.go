@@ -0,0 +1,127 @@
+package pluralize //nolint:testpackage
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func streamTests() []string {
+	return []string{
+		`the [[dog]], barking.`,
+		`[[Child]] and [[PERSON]] [[mouse]]`,
+		`"[["box"]]" said  the   [[fox]]!`,
+		`unterminated [[dog`,
+		`trailing [`,
+		`[[[dog]]] ]] [ [[]]`,
+		`日本語 [[café]] ünïcödé [[person]]`,
+		``,
+	}
+}
+
+func TestPluralizeWriter(t *testing.T) {
+	pluralize := NewClient()
+
+	for _, input := range streamTests() {
+		expected := pluralize.PluralizeText(input)
+
+		for split := 0; split <= len(input); split++ {
+			var buf bytes.Buffer
+
+			w := pluralize.PluralizeWriter(&buf)
+
+			for _, chunk := range []string{input[:split], input[split:]} {
+				if n, err := w.Write([]byte(chunk)); err != nil || n != len(chunk) {
+					t.Fatalf("Write(%q) = %d, %v", chunk, n, err)
+				}
+			}
+
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			if buf.String() != expected {
+				t.Errorf("FAIL split %d of %q expected %q, actual %q", split, input, expected, buf.String())
+			}
+		}
+	}
+}
+
+func TestSingularizeWriterByteAtATime(t *testing.T) {
+	pluralize := NewClient()
+
+	input := `all [[dogs]] and [[geese]] are [[Mice]]`
+
+	var buf bytes.Buffer
+
+	w := pluralize.SingularizeWriter(&buf)
+
+	for i := 0; i < len(input); i++ {
+		if _, err := w.Write([]byte{input[i]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := pluralize.SingularizeText(input); buf.String() != expected {
+		t.Errorf("expected %q, actual %q", expected, buf.String())
+	}
+}
+
+func TestPluralizeReader(t *testing.T) {
+	pluralize := NewClient()
+
+	for _, input := range streamTests() {
+		expected := pluralize.PluralizeText(input)
+
+		for _, r := range []io.Reader{
+			pluralize.PluralizeReader(strings.NewReader(input)),
+			pluralize.PluralizeReader(iotest.OneByteReader(strings.NewReader(input))),
+			pluralize.PluralizeReader(iotest.DataErrReader(strings.NewReader(input))),
+			iotest.HalfReader(pluralize.PluralizeReader(strings.NewReader(input))),
+		} {
+			actual, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(actual) != expected {
+				t.Errorf("FAIL %q expected %q, actual %q", input, expected, actual)
+			}
+		}
+	}
+}
+
+func TestPluralizeWriterLongMarker(t *testing.T) {
+	pluralize := NewClient()
+
+	input := `[[` + strings.Repeat(`x`, 2*MaxMarkerLen) + ` [[dog]]`
+
+	var buf bytes.Buffer
+
+	w := pluralize.PluralizeWriter(&buf)
+
+	for i := 0; i < len(input); i += 100 {
+		end := i + 100
+		if end > len(input) {
+			end = len(input)
+		}
+
+		if _, err := w.Write([]byte(input[i:end])); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `[[` + strings.Repeat(`x`, 2*MaxMarkerLen) + ` dogs`; buf.String() != expected {
+		t.Errorf("unexpected output of length %d", buf.Len())
+	}
+}
@@ -0,0 +1,158 @@
+package pluralize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Marker delimiters for tokens to inflect in running text (e.g. the [[dog]] barks).
+const (
+	MarkerOpen  = "[["
+	MarkerClose = "]]"
+)
+
+// TokenKind -- enum.
+type TokenKind uint8
+
+// TokenKind -- enum constants.
+const (
+	TokenWord TokenKind = iota
+	TokenNumber
+	TokenSpace
+	TokenPunct
+	TokenMarked
+)
+
+// Token -- text token, Offset is the byte offset of the token in the input.
+// The Text of a TokenMarked token excludes the marker delimiters.
+type Token struct {
+	Kind   TokenKind
+	Text   string
+	Offset int
+}
+
+// Tokenize -- split text into word, number, space, punctuation and marked tokens.
+// Words may contain inner apostrophes and hyphens (e.g. don't, low-life).
+func Tokenize(text string) []Token {
+	var tokens []Token
+
+	for i := 0; i < len(text); {
+		if strings.HasPrefix(text[i:], MarkerOpen) {
+			if end := strings.Index(text[i+len(MarkerOpen):], MarkerClose); end >= 0 {
+				inner := text[i+len(MarkerOpen) : i+len(MarkerOpen)+end]
+				tokens = append(tokens, Token{Kind: TokenMarked, Text: inner, Offset: i})
+				i += len(MarkerOpen) + end + len(MarkerClose)
+
+				continue
+			}
+		}
+
+		r, size := utf8.DecodeRuneInString(text[i:])
+
+		var kind TokenKind
+
+		switch {
+		case unicode.IsSpace(r):
+			kind = TokenSpace
+			size = spanFunc(text[i:], unicode.IsSpace)
+		case unicode.IsDigit(r):
+			kind = TokenNumber
+			size = spanNumber(text[i:])
+		case unicode.IsLetter(r):
+			kind = TokenWord
+			size = spanWord(text[i:])
+		default:
+			kind = TokenPunct
+		}
+
+		tokens = append(tokens, Token{Kind: kind, Text: text[i : i+size], Offset: i})
+		i += size
+	}
+
+	return tokens
+}
+
+// PluralizeText -- pluralize the marked tokens in text, preserving all other text (e.g. the [[dog]], barking => the dogs, barking).
+func (c *Client) PluralizeText(text string) string {
+	return c.inflectText(text, c.Plural)
+}
+
+// SingularizeText -- singularize the marked tokens in text, preserving all other text.
+func (c *Client) SingularizeText(text string) string {
+	return c.inflectText(text, c.Singular)
+}
+
+func (c *Client) inflectText(text string, inflect func(string) string) string {
+	var sb strings.Builder
+
+	for _, t := range Tokenize(text) {
+		if t.Kind == TokenMarked {
+			sb.WriteString(inflectMarked(t.Text, inflect))
+			continue
+		}
+
+		sb.WriteString(t.Text)
+	}
+
+	return sb.String()
+}
+
+// inflectMarked -- inflect the marked text, preserving leading and trailing spaces, quotes and punctuation.
+func inflectMarked(text string, inflect func(string) string) string {
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+
+	start := strings.IndexFunc(text, isWord)
+	if start < 0 {
+		return text
+	}
+
+	end := strings.LastIndexFunc(text, isWord)
+	_, size := utf8.DecodeRuneInString(text[end:])
+	end += size
+
+	return text[:start] + inflect(text[start:end]) + text[end:]
+}
+
+func spanFunc(s string, f func(rune) bool) int {
+	for i, r := range s {
+		if !f(r) {
+			return i
+		}
+	}
+
+	return len(s)
+}
+
+// spanWord -- letters and digits, with inner apostrophes and hyphens followed by a letter.
+func spanWord(s string) int {
+	isWord := func(r rune) bool {
+		return unicode.IsLetter(r) || unicode.IsDigit(r)
+	}
+
+	n := spanFunc(s, isWord)
+
+	for n < len(s) && (s[n] == '\'' || s[n] == '-') {
+		r, _ := utf8.DecodeRuneInString(s[n+1:])
+		if !unicode.IsLetter(r) {
+			break
+		}
+
+		n += 1 + spanFunc(s[n+1:], isWord)
+	}
+
+	return n
+}
+
+// spanNumber -- digits with inner group separators and decimal point (e.g. 1,000.5).
+func spanNumber(s string) int {
+	n := spanFunc(s, unicode.IsDigit)
+
+	for n+1 < len(s) && (s[n] == ',' || s[n] == '.') && s[n+1] >= '0' && s[n+1] <= '9' {
+		n += 1 + spanFunc(s[n+1:], unicode.IsDigit)
+	}
+
+	return n
+}
@@ -0,0 +1,78 @@
+package pluralize //nolint:testpackage
+
+import (
+	"testing"
+)
+
+func TestPluralizeText(t *testing.T) {
+	tests := []TestEntry{
+		{`the [[dog]], barking.`, `the dogs, barking.`},
+		{`the dog, barking.`, `the dog, barking.`},
+		{`[[Child]] and [[PERSON]]`, `Children and PEOPLE`},
+		{`"[["box"]]" said  the   [[fox]]!`, `""boxes"" said  the   foxes!`},
+		{`[["box,"]]`, `"boxes,"`},
+		{`a [[black olive]]`, `a black olives`},
+		{`unterminated [[dog`, `unterminated [[dog`},
+		{`empty [[]] [[ ]]`, `empty   `},
+		{`the [[dog]][[cat]]`, `the dogscats`},
+		{`tabs	and
+newlines [[mouse]]`, `tabs	and
+newlines mice`},
+	}
+	passed := 0
+	failed := 0
+
+	pluralize := NewClient()
+
+	for i, testItem := range tests {
+		if actual := pluralize.PluralizeText(testItem.input); actual == testItem.expected {
+			plogf(t, "PASS test[%d] func %s(%s) expected %s, actual %s", i, "PluralizeText",
+				testItem.input, testItem.expected, actual)
+			passed++
+		} else {
+			t.Errorf("FAIL test[%d] func %s(%s) expected %s, actual %s", i, "PluralizeText",
+				testItem.input, testItem.expected, actual)
+			failed++
+		}
+	}
+
+	slog("TestPluralizeText", passed, failed, len(tests))
+}
+
+func TestSingularizeText(t *testing.T) {
+	pluralize := NewClient()
+
+	if actual := pluralize.SingularizeText(`All [[dogs]] and 'the [[geese']]'.`); actual != `All dog and 'the goose''.` {
+		t.Errorf("unexpected %s", actual)
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize(`I don't own 1,000.5 low-life [[dog]]s.`)
+
+	expected := []Token{
+		{TokenWord, `I`, 0},
+		{TokenSpace, ` `, 1},
+		{TokenWord, `don't`, 2},
+		{TokenSpace, ` `, 7},
+		{TokenWord, `own`, 8},
+		{TokenSpace, ` `, 11},
+		{TokenNumber, `1,000.5`, 12},
+		{TokenSpace, ` `, 19},
+		{TokenWord, `low-life`, 20},
+		{TokenSpace, ` `, 28},
+		{TokenMarked, `dog`, 29},
+		{TokenWord, `s`, 36},
+		{TokenPunct, `.`, 37},
+	}
+
+	if len(tokens) != len(expected) {
+		t.Fatalf("expected %d tokens, actual %d %v", len(expected), len(tokens), tokens)
+	}
+
+	for i := range tokens {
+		if tokens[i] != expected[i] {
+			t.Errorf("FAIL token[%d] expected %v, actual %v", i, expected[i], tokens[i])
+		}
+	}
+}
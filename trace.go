@@ -0,0 +1,146 @@
+package pluralize
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Stage -- enum, the stage of Plural or Singular resolving a word.
+type Stage uint8
+
+// Stage -- enum constants.
+const (
+	// StageNone -- no rule matched, the word is returned unchanged.
+	StageNone Stage = iota
+	// StageEmpty -- the word is empty.
+	StageEmpty
+	// StageKeep -- the word is an irregular word already in the requested form.
+	StageKeep
+	// StageIrregular -- the word is an irregular word replaced by its irregular form.
+	StageIrregular
+	// StageUncountable -- the word is an uncountable word.
+	StageUncountable
+	// StageRule -- the word is replaced by the first matching rule.
+	StageRule
+)
+
+// Stage -- string constants.
+const (
+	stageNone        = "none"
+	stageEmpty       = "empty"
+	stageKeep        = "keep"
+	stageIrregular   = "irregular"
+	stageUncountable = "uncountable"
+	stageRule        = "rule"
+)
+
+// String -- stringify Stage.
+func (s Stage) String() string {
+	return map[Stage]string{
+		StageNone:        stageNone,
+		StageEmpty:       stageEmpty,
+		StageKeep:        stageKeep,
+		StageIrregular:   stageIrregular,
+		StageUncountable: stageUncountable,
+		StageRule:        stageRule,
+	}[s]
+}
+
+// Trace -- how Plural or Singular resolved a word.
+// RuleIndex, Expression, Replacement, Match and Captures are only set for StageRule, RuleIndex is
+// the position of the rule in the order rules were added (the built-in rules first). Expression is
+// also set for StageUncountable when the uncountable word is a regular expression. Match is the
+// text matched by the rule expression and Captures its capture groups ($1, $2, ...), groups which
+// did not participate in the match are empty.
+type Trace struct {
+	Word        string
+	Result      string
+	Stage       Stage
+	RuleIndex   int
+	Expression  string
+	Replacement string
+	Match       string
+	Captures    []string
+}
+
+// String -- stringify Trace (e.g. rule 17: (?i)(x|ch|ss|sh|zz)$ => $1es).
+func (t Trace) String() string {
+	if t.Stage == StageRule {
+		return fmt.Sprintf("%s %d: %s => %s", t.Stage, t.RuleIndex, t.Expression, t.Replacement)
+	}
+
+	return t.Stage.String()
+}
+
+// TracePlural -- Pluralize a word, returning how the result was resolved.
+func (c *Client) TracePlural(word string) Trace {
+	return c.traceWord(c.irregularSingles, c.irregularPlurals, c.pluralRules)(word)
+}
+
+// TraceSingular -- Singularize a word, returning how the result was resolved.
+func (c *Client) TraceSingular(word string) Trace {
+	return c.traceWord(c.irregularPlurals, c.irregularSingles, c.singularRules)(word)
+}
+
+// traceWord -- mirrors replaceWord and sanitizeWord, recording the resolving stage.
+func (c *Client) traceWord(replaceMap map[string]string, keepMap map[string]string, rules []Rule) func(w string) Trace { //nolint:lll
+	f := func(word string) Trace {
+		var token = strings.ToLower(word)
+
+		t := Trace{Word: word, Result: word, RuleIndex: -1}
+
+		if _, ok := keepMap[token]; ok {
+			t.Stage = StageKeep
+			t.Result = restoreCase(word, token)
+
+			return t
+		}
+
+		if replaceToken, ok := replaceMap[token]; ok {
+			t.Stage = StageIrregular
+			t.Result = restoreCase(word, replaceToken)
+
+			return t
+		}
+
+		if len(token) == 0 {
+			t.Stage = StageEmpty
+			return t
+		}
+
+		if _, ok := c.uncountables[token]; ok {
+			t.Stage = StageUncountable
+			return t
+		}
+
+		for i := len(rules) - 1; i >= 0; i-- {
+			if !rules[i].expression.MatchString(word) {
+				continue
+			}
+
+			if rules[i].uncountable {
+				t.Stage = StageUncountable
+				t.Expression = rules[i].expression.String()
+				t.Result = c.replace(word, rules[i])
+
+				break
+			}
+
+			t.Stage = StageRule
+			t.RuleIndex = i
+			t.Expression = rules[i].expression.String()
+			t.Replacement = rules[i].replacement
+			t.Result = c.replace(word, rules[i])
+
+			if m := rules[i].expression.FindStringSubmatch(word); m != nil {
+				t.Match, t.Captures = m[0], m[1:]
+			}
+
+			break
+		}
+
+		return t
+	}
+
+	return f
+}
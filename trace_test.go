@@ -0,0 +1,89 @@
+package pluralize //nolint:testpackage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	pluralize := NewClient()
+
+	tests := []struct {
+		trace    Trace
+		stage    Stage
+		result   string
+		expected string
+	}{
+		{pluralize.TracePlural(`box`), StageRule, `boxes`, `rule 17: (?i)(x|ch|ss|sh|zz)$ => $1es`},
+		{pluralize.TracePlural(`Goose`), StageIrregular, `Geese`, `irregular`},
+		{pluralize.TracePlural(`geese`), StageKeep, `geese`, `keep`},
+		{pluralize.TracePlural(`Firmware`), StageUncountable, `Firmware`, `uncountable`},
+		{pluralize.TracePlural(`sheep`), StageUncountable, `sheep`, `uncountable`},
+		{pluralize.TraceSingular(`Deer`), StageUncountable, `Deer`, `uncountable`},
+		{pluralize.TracePlural(``), StageEmpty, ``, `empty`},
+		{pluralize.TraceSingular(`cacti`), StageRule, `cactus`, `rule 14: (?i)(alumn|syllab|vir|radi|nucle|fung|cact|stimul|termin|bacill|foc|uter|loc|strat)(?:us|i)$ => $1us`}, //nolint:lll,misspell
+		{pluralize.TraceSingular(`日本語`), StageNone, `日本語`, `none`},
+	}
+
+	for i, test := range tests {
+		if test.trace.Stage != test.stage || test.trace.Result != test.result || test.trace.String() != test.expected {
+			t.Errorf("FAIL test[%d] %s expected %s %s %s, actual %s %s %s", i, test.trace.Word,
+				test.stage, test.result, test.expected, test.trace.Stage, test.trace.Result, test.trace)
+		}
+	}
+}
+
+func TestTraceCaptures(t *testing.T) {
+	pluralize := NewClient()
+
+	tests := []struct {
+		trace    Trace
+		match    string
+		captures []string
+	}{
+		{pluralize.TracePlural(`Cactus`), `Cactus`, []string{`Cact`}},
+		{pluralize.TracePlural(`box`), `x`, []string{`x`}},
+		{pluralize.TraceSingular(`boxes`), `xes`, []string{`x`}},
+		{pluralize.TraceSingular(`Cacti`), `Cacti`, []string{`Cact`}},
+		{pluralize.TracePlural(`Goose`), ``, nil},
+	}
+
+	for i, test := range tests {
+		if test.trace.Match != test.match || strings.Join(test.trace.Captures, `|`) != strings.Join(test.captures, `|`) ||
+			len(test.trace.Captures) != len(test.captures) {
+			t.Errorf("FAIL test[%d] %s expected %q %q, actual %q %q", i, test.trace.Word,
+				test.match, test.captures, test.trace.Match, test.trace.Captures)
+		}
+	}
+}
+
+func TestTraceUncountableExpression(t *testing.T) {
+	pluralize := NewClient()
+
+	if tr := pluralize.TracePlural(`sheep`); tr.Expression != `(?i)sheep$` || tr.RuleIndex != -1 {
+		t.Errorf("FAIL TracePlural(sheep) expected (?i)sheep$, actual %s %d", tr.Expression, tr.RuleIndex)
+	}
+
+	if tr := pluralize.TracePlural(`Firmware`); len(tr.Expression) != 0 {
+		t.Errorf("FAIL TracePlural(Firmware) expected no expression, actual %s", tr.Expression)
+	}
+}
+
+// TestTraceResult -- traced results match Plural and Singular for the built-in corpus.
+func TestTraceResult(t *testing.T) {
+	tests := append(append(basicTests(), singularTests()...), pluralTests()...)
+
+	pluralize := NewClient()
+
+	for i, testItem := range tests {
+		for _, w := range []string{testItem.input, testItem.expected} {
+			if actual := pluralize.TracePlural(w).Result; actual != pluralize.Plural(w) {
+				t.Errorf("FAIL test[%d] TracePlural(%s) expected %s, actual %s", i, w, pluralize.Plural(w), actual)
+			}
+
+			if actual := pluralize.TraceSingular(w).Result; actual != pluralize.Singular(w) {
+				t.Errorf("FAIL test[%d] TraceSingular(%s) expected %s, actual %s", i, w, pluralize.Singular(w), actual)
+			}
+		}
+	}
+}
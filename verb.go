@@ -0,0 +1,200 @@
+package pluralize
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Agree -- Inflect a noun and the verb agreeing with it based on the passed in count.
+//
+//	count: how many of the noun exist
+//	noun: the subject noun (e.g. file)
+//	verb: the verb or verb phrase, only the first word is inflected (e.g. was deleted)
+func (c *Client) Agree(count int, noun string, verb string) string {
+	return fmt.Sprintf("%d %s %s", count, c.Pluralize(noun, count, false), c.AgreeVerb(verb, count))
+}
+
+// AgreeVerb -- Inflect a verb or verb phrase to agree with a third-person subject of the passed in count.
+// Regular verbs can be passed in either their base (run) or third-person singular (runs) form.
+func (c *Client) AgreeVerb(verb string, count int) string {
+	word, rest := verb, ``
+	if i := strings.IndexAny(verb, " \t"); i >= 0 {
+		word, rest = verb[:i], verb[i:]
+	}
+
+	if count == 1 {
+		return c.singularVerb(word) + rest
+	}
+
+	return c.pluralVerb(word) + rest
+}
+
+// AddIrregularVerbRule -- Add an irregular verb definition (e.g. does, do).
+func (c *Client) AddIrregularVerbRule(single string, plural string) {
+	p := strings.ToLower(plural)
+	s := strings.ToLower(single)
+
+	c.irregularVerbSingles[s] = p
+	c.irregularVerbPlurals[p] = s
+}
+
+// AddInvariantVerbRule -- Add a verb which does not inflect for number (e.g. can, will).
+func (c *Client) AddInvariantVerbRule(verb string) {
+	c.invariantVerbRules = append(c.invariantVerbRules, Rule{expression: sanitizeRule(verb), replacement: `$0`})
+}
+
+// singularVerb -- third-person singular form of a verb (e.g. run => runs).
+func (c *Client) singularVerb(verb string) string {
+	token := strings.ToLower(verb)
+
+	if single, ok := c.verbIrregular(token, false); ok {
+		return restoreCase(verb, single)
+	}
+
+	if c.isInvariantVerb(verb) || c.isThirdPersonVerb(verb) {
+		return verb
+	}
+
+	return c.applyVerbRules(verb, c.thirdPersonVerbRules)
+}
+
+// pluralVerb -- base form of a verb used with plural subjects (e.g. runs => run).
+func (c *Client) pluralVerb(verb string) string {
+	token := strings.ToLower(verb)
+
+	if plural, ok := c.verbIrregular(token, true); ok {
+		return restoreCase(verb, plural)
+	}
+
+	if c.isInvariantVerb(verb) || !c.isThirdPersonVerb(verb) {
+		return verb
+	}
+
+	return c.applyVerbRules(verb, c.baseVerbRules)
+}
+
+// verbIrregular -- lookup a verb in the irregular verb maps, returns the requested form.
+func (c *Client) verbIrregular(token string, plural bool) (string, bool) {
+	if p, ok := c.irregularVerbSingles[token]; ok {
+		if plural {
+			return p, true
+		}
+		return token, true
+	}
+
+	if s, ok := c.irregularVerbPlurals[token]; ok {
+		if plural {
+			return token, true
+		}
+		return s, true
+	}
+
+	return ``, false
+}
+
+func (c *Client) isInvariantVerb(verb string) bool {
+	for _, r := range c.invariantVerbRules {
+		if r.expression.MatchString(verb) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isThirdPersonVerb -- a verb is in the third-person singular form when it round trips through the base form,
+// a base form ending in s may also double it (e.g. busses, focusses).
+func (c *Client) isThirdPersonVerb(verb string) bool {
+	base := c.applyVerbRules(verb, c.baseVerbRules)
+	if base == verb {
+		return false
+	}
+
+	if strings.HasSuffix(strings.ToLower(base), `s`) && strings.EqualFold(base+`ses`, verb) {
+		return true
+	}
+
+	return strings.EqualFold(c.applyVerbRules(base, c.thirdPersonVerbRules), verb)
+}
+
+func (c *Client) applyVerbRules(verb string, rules []Rule) string {
+	if len(verb) == 0 {
+		return verb
+	}
+
+	// NOTE: iterate rules array in reverse order specific => general rules
+	for i := len(rules) - 1; i >= 0; i-- {
+		if rules[i].expression.MatchString(verb) {
+			return c.replace(verb, rules[i])
+		}
+	}
+
+	return verb
+}
+
+func (c *Client) loadVerbRules() {
+	var irregularVerbRules = []struct {
+		single string
+		plural string
+	}{
+		{`is`, `are`},
+		{`was`, `were`},
+		{`has`, `have`},
+		{`does`, `do`},
+		{`goes`, `go`},
+		{`isn't`, `aren't`},
+		{`wasn't`, `weren't`},
+		{`hasn't`, `haven't`},
+		{`doesn't`, `don't`},
+	}
+
+	for _, r := range irregularVerbRules {
+		c.AddIrregularVerbRule(r.single, r.plural)
+	}
+
+	var thirdPersonVerbRules = []struct {
+		rule        string
+		replacement string
+	}{
+		{`(?i)$`, `s`},
+		{`(?i)(s|x|z|ch|sh|o)$`, `$1es`},
+		{`(?i)([^aeiou])y$`, `$1ies`},
+	}
+
+	for _, r := range thirdPersonVerbRules {
+		c.thirdPersonVerbRules = append(c.thirdPersonVerbRules, Rule{expression: sanitizeRule(r.rule), replacement: r.replacement})
+	}
+
+	var baseVerbRules = []struct {
+		rule        string
+		replacement string
+	}{
+		{`(?i)([^s])s$`, `$1`},
+		{`(?i)(ss|x|zz|ch|sh|o)es$`, `$1`},
+		{`(?i)([^aeiou])ies$`, `$1y`},
+		// Verbs ending in -ie (e.g. dies, unties).
+		{`(?i)^(un|be)?([a-z])ies$`, `$1$2ie`},
+		// Verbs ending in -che and -oe (e.g. caches, tiptoes).
+		{`(?i)^(ache|(?:pre|re)?cache|canoe|hoe|shoe|tiptoe|toe)s$`, `$1`},
+		// Verbs ending in a single s are already in the base form or take -es, most -uses and -ases
+		// verbs end in e (e.g. abuses, releases) and are handled by the general rule.
+		{`(?i)^(re)?(focus|bus|gas|bias|alias|canvas)$`, `$0`},
+		{`(?i)^(re)?(focus|bus|gas|bias|alias|canvas)s?es$`, `$1$2`},
+	}
+
+	for _, r := range baseVerbRules {
+		c.baseVerbRules = append(c.baseVerbRules, Rule{expression: sanitizeRule(r.rule), replacement: r.replacement})
+	}
+
+	var invariantVerbRules = []string{
+		// Modal verbs.
+		`(?i)^(?:can|could|may|might|must|shall|should|will|would)(?:n't|not)?$`,
+		// Simple past tense.
+		`(?i)^(?:did|didn't|had|hadn't)$`,
+		`(?i)[^e]ed$`,
+	}
+
+	for _, r := range invariantVerbRules {
+		c.AddInvariantVerbRule(r)
+	}
+}
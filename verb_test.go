@@ -0,0 +1,153 @@
+package pluralize //nolint:testpackage
+
+import (
+	"testing"
+)
+
+// Verb agreement test cases of third-person singular - plural pairs.
+func verbTests() []TestEntry {
+	return []TestEntry{
+		// Irregular.
+		{`is`, `are`},
+		{`was`, `were`},
+		{`has`, `have`},
+		{`does`, `do`},
+		{`goes`, `go`},
+		{`doesn't`, `don't`},
+		{`Was`, `Were`},
+		// Regular.
+		{`runs`, `run`},
+		{`fails`, `fail`},
+		{`passes`, `pass`},
+		{`fixes`, `fix`},
+		{`watches`, `watch`},
+		{`pushes`, `push`},
+		{`buzzes`, `buzz`},
+		{`echoes`, `echo`},
+		{`tries`, `try`},
+		{`stays`, `stay`},
+		{`uses`, `use`},
+		{`focuses`, `focus`},
+		{`Buses`, `Bus`},
+		{`gases`, `gas`},
+		{`biases`, `bias`},
+		{`abuses`, `abuse`},
+		{`causes`, `cause`},
+		{`releases`, `release`},
+		{`dies`, `die`},
+		{`lies`, `lie`},
+		{`ties`, `tie`},
+		{`vies`, `vie`},
+		{`unties`, `untie`},
+		{`relies`, `rely`},
+		{`caches`, `cache`},
+		{`aches`, `ache`},
+		{`reaches`, `reach`},
+		{`canoes`, `canoe`},
+		{`tiptoes`, `tiptoe`},
+		{`vetoes`, `veto`},
+		{`RUNS`, `RUN`},
+		// Invariant.
+		{`can`, `can`},
+		{`will`, `will`},
+		{`shouldn't`, `shouldn't`},
+		{`deleted`, `deleted`},
+		{`had`, `had`},
+	}
+}
+
+func TestAgreeVerb(t *testing.T) {
+	tests := verbTests()
+	passed := 0
+	failed := 0
+
+	pluralize := NewClient()
+
+	for i, testItem := range tests {
+		for _, input := range []string{testItem.input, testItem.expected} {
+			single := pluralize.AgreeVerb(input, 1)
+			plural := pluralize.AgreeVerb(input, 2)
+
+			if single == testItem.input && plural == testItem.expected {
+				plogf(t, "PASS test[%d] func %s(%s) expected %s/%s, actual %s/%s", i, "AgreeVerb",
+					input, testItem.input, testItem.expected, single, plural)
+				passed++
+			} else {
+				t.Errorf("FAIL test[%d] func %s(%s) expected %s/%s, actual %s/%s", i, "AgreeVerb",
+					input, testItem.input, testItem.expected, single, plural)
+				failed++
+			}
+		}
+	}
+
+	slog("TestAgreeVerb", passed, failed, 2*len(tests))
+}
+
+func TestAgree(t *testing.T) {
+	pluralize := NewClient()
+
+	if pluralize.Agree(1, `file`, `was deleted`) != `1 file was deleted` {
+		t.Fail()
+	}
+
+	if pluralize.Agree(3, `file`, `was deleted`) != `3 files were deleted` {
+		t.Fail()
+	}
+
+	if pluralize.Agree(1, `jobs`, `run`) != `1 job runs` {
+		t.Fail()
+	}
+
+	if pluralize.Agree(2, `job`, `runs`) != `2 jobs run` {
+		t.Fail()
+	}
+
+	if pluralize.Agree(0, `child`, `has left`) != `0 children have left` {
+		t.Fail()
+	}
+
+	if pluralize.Agree(1, `task`, `will run`) != `1 task will run` {
+		t.Fail()
+	}
+
+	if pluralize.Agree(2, `server`, `caches results`) != `2 servers cache results` {
+		t.Fail()
+	}
+
+	if pluralize.Agree(3, `process`, `dies`) != `3 processes die` {
+		t.Fail()
+	}
+
+	// The doubled spelling of a single s verb is kept for a single subject.
+	if pluralize.AgreeVerb(`busses`, 1) != `busses` || pluralize.AgreeVerb(`busses`, 2) != `bus` {
+		t.Fail()
+	}
+}
+
+func TestNewIrregularVerbRule(t *testing.T) {
+	pluralize := NewClient()
+
+	if pluralize.AgreeVerb(`be`, 1) != `bes` {
+		t.Fail()
+	}
+
+	pluralize.AddIrregularVerbRule(`is`, `be`)
+
+	if pluralize.AgreeVerb(`be`, 1) != `is` {
+		t.Fail()
+	}
+}
+
+func TestNewInvariantVerbRule(t *testing.T) {
+	pluralize := NewClient()
+
+	if pluralize.AgreeVerb(`ought`, 1) != `oughts` {
+		t.Fail()
+	}
+
+	pluralize.AddInvariantVerbRule(`ought`)
+
+	if pluralize.AgreeVerb(`ought`, 1) != `ought` {
+		t.Fail()
+	}
+}